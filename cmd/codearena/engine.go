@@ -20,6 +20,7 @@ var (
 	engineTickRate     int
 	engineRedisAddr    string
 	engineDbPath       string
+	engineClassesPath  string
 )
 
 var engineCmd = &cobra.Command{
@@ -52,6 +53,7 @@ This component handles:
 		engineTickRate = viper.GetInt("tick-rate")
 		engineRedisAddr = viper.GetString("redis-addr")
 		engineDbPath = viper.GetString("db-path")
+		engineClassesPath = viper.GetString("classes")
 
 		cfg := core.Config{
 			GRPCPort:     engineGrpcPort,
@@ -64,6 +66,7 @@ This component handles:
 			TickRate:     engineTickRate,
 			RedisAddr:    engineRedisAddr,
 			DBPath:       engineDbPath,
+			ClassesPath:  engineClassesPath,
 		}
 		if err := core.Start(cfg); err != nil {
 			slog.Error("Engine Failed", "error", err)
@@ -83,6 +86,7 @@ func init() {
 	engineCmd.Flags().IntVar(&engineTickRate, "tick-rate", 60, "Game loop ticks per second")
 	engineCmd.Flags().StringVar(&engineRedisAddr, "redis-addr", "localhost:6379", "Redis address for horizontal scaling")
 	engineCmd.Flags().StringVar(&engineDbPath, "db-path", "codearena.db", "Path to SQLite database file")
+	engineCmd.Flags().StringVar(&engineClassesPath, "classes", "", "YAML or JSON file with extra bot class sets")
 
 	viper.BindPFlag("grpc-port", engineCmd.Flags().Lookup("grpc-port"))
 	viper.BindPFlag("web-port", engineCmd.Flags().Lookup("web-port"))
@@ -94,6 +98,7 @@ func init() {
 	viper.BindPFlag("tick-rate", engineCmd.Flags().Lookup("tick-rate"))
 	viper.BindPFlag("redis-addr", engineCmd.Flags().Lookup("redis-addr"))
 	viper.BindPFlag("db-path", engineCmd.Flags().Lookup("db-path"))
+	viper.BindPFlag("classes", engineCmd.Flags().Lookup("classes"))
 
 	rootCmd.AddCommand(engineCmd)
 }
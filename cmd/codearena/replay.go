@@ -7,6 +7,8 @@ import (
 	"os"
 	"text/tabwriter"
 
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	"github.com/codearena-platform/codearena-core/internal/persistence"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -17,6 +19,7 @@ var (
 	replayAddr      string
 	replayStartTick int64
 	replayEndTick   int64
+	replayDbPath    string
 )
 
 var replayCmd = &cobra.Command{
@@ -113,14 +116,46 @@ var replayLogsCmd = &cobra.Command{
 	},
 }
 
+var replayVerifyCmd = &cobra.Command{
+	Use:   "verify [match-id]",
+	Short: "Re-simulate a match from its recorded intents",
+	Long: `Replays the recorded bot intents of a match through a fresh engine and
+checks that every tick reproduces the recorded state hash.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		matchID := args[0]
+		db, err := persistence.NewDatabase(replayDbPath)
+		if err != nil {
+			slog.Error("Failed to open database", "error", err)
+			os.Exit(1)
+		}
+		defer db.Close()
+
+		records, err := services.LoadTickRecords(db, matchID)
+		if err != nil {
+			slog.Error("Failed to load recording", "match_id", matchID, "error", err)
+			os.Exit(1)
+		}
+
+		ticks, err := services.VerifyReplay(records)
+		if err != nil {
+			fmt.Printf("Match %s FAILED verification after %d ticks: %v\n", matchID, ticks, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Match %s verified: %d ticks reproduced\n", matchID, ticks)
+	},
+}
+
 func init() {
 	replayCmd.PersistentFlags().StringVar(&replayAddr, "addr", "localhost:50051", "Address of the core service")
 	replayLogsCmd.Flags().Int64Var(&replayStartTick, "start", 0, "Start tick")
 	replayLogsCmd.Flags().Int64Var(&replayEndTick, "end", 0, "End tick")
+	replayVerifyCmd.Flags().StringVar(&replayDbPath, "db-path", "codearena.db", "Path to SQLite database file")
 
 	replayCmd.AddCommand(replayListCmd)
 	replayCmd.AddCommand(replayHighlightsCmd)
 	replayCmd.AddCommand(replayLogsCmd)
+	replayCmd.AddCommand(replayVerifyCmd)
 
 	rootCmd.AddCommand(replayCmd)
 }
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	// Registers the built-in AIs the --bots flag selects
+	_ "github.com/codearena-platform/codearena-core/internal/bots"
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var (
+	simulateArenaPath    string
+	simulateBots         []string
+	simulateTicks        int64
+	simulateSeed         int64
+	simulateReplayPath   string
+	simulateIntentsPath  string
+	simulateIntentsMatch string
+	simulateClassesPath  string
+)
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Run a headless match as fast as possible",
+	Long: `Simulates a match without a game loop, network or containers and prints
+the result. Bots are built-in AIs selected by name (sitting-duck, spinner,
+wall-crawler, tracker, sniper), or recorded bots whose intents are replayed
+from an earlier match: "recorded:<bot-id>" reads them from the database given
+by --intents.
+
+Bots join in order as bot_1, bot_2, ..., followed by the builtin_bots of the
+arena file.`,
+	Example: `  # Pit the sniper against the wall crawler for 10,000 ticks
+  codearena simulate --arena map.yaml --bots sniper,wall-crawler --ticks 10000 --seed 42
+
+  # Replay bot_2 of a recorded match against the tracker, saving a replay
+  codearena simulate --bots tracker,recorded:bot_2 --intents old.db --intents-match m1 --replay new.db`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runSimulation(cmd); err != nil {
+			slog.Error("Simulation failed", "error", err)
+			os.Exit(1)
+		}
+	},
+}
+
+// runSimulation runs the headless match described by the flags.
+func runSimulation(cmd *cobra.Command) error {
+	if simulateClassesPath != "" {
+		if err := services.DefaultClasses.LoadFile(simulateClassesPath); err != nil {
+			return fmt.Errorf("failed to load bot classes: %w", err)
+		}
+	}
+
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	if simulateArenaPath != "" {
+		var err error
+		if arena, err = services.LoadArenaFile(simulateArenaPath); err != nil {
+			return err
+		}
+	}
+	if simulateSeed != 0 {
+		arena.Seed = simulateSeed
+	}
+
+	if len(simulateBots) == 0 && len(arena.BuiltinBots) == 0 {
+		return fmt.Errorf("at least one bot is required, from --bots or the arena's builtin_bots")
+	}
+	bots, err := simulationBots(simulateBots)
+	if err != nil {
+		return err
+	}
+
+	var db *persistence.Database
+	if simulateReplayPath != "" {
+		if db, err = persistence.NewDatabase(simulateReplayPath); err != nil {
+			return fmt.Errorf("failed to open replay database: %w", err)
+		}
+		defer db.Close()
+	}
+
+	result, err := services.RunHeadless(arena, bots, simulateTicks, db)
+	if err != nil {
+		return err
+	}
+	printSimulationResult(cmd, result)
+	if db != nil {
+		slog.Info("Replay written", "path", simulateReplayPath, "match_id", result.MatchID)
+	}
+	return nil
+}
+
+// simulationBots resolves --bots entries to in-process or recorded bots.
+func simulationBots(specs []string) ([]services.HeadlessBot, error) {
+	var records []*pb.TickRecord
+	bots := make([]services.HeadlessBot, 0, len(specs))
+	for _, spec := range specs {
+		if botID, ok := strings.CutPrefix(spec, "recorded:"); ok {
+			if records == nil {
+				var err error
+				if records, err = loadRecordedIntents(); err != nil {
+					return nil, err
+				}
+			}
+			ctrl, err := services.NewRecordedController(records, botID)
+			if err != nil {
+				return nil, err
+			}
+			bots = append(bots, services.HeadlessBot{Name: botID, Controller: ctrl})
+			continue
+		}
+
+		factory, ok := services.LookupController(spec)
+		if !ok {
+			return nil, fmt.Errorf("unknown bot %q (available: %s)", spec, strings.Join(services.ControllerNames(), ", "))
+		}
+		bots = append(bots, services.HeadlessBot{Name: spec, Controller: factory()})
+	}
+	return bots, nil
+}
+
+func loadRecordedIntents() ([]*pb.TickRecord, error) {
+	if simulateIntentsPath == "" || simulateIntentsMatch == "" {
+		return nil, fmt.Errorf("recorded bots need --intents and --intents-match")
+	}
+	db, err := persistence.NewDatabase(simulateIntentsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open intents database: %w", err)
+	}
+	defer db.Close()
+	return services.LoadTickRecords(db, simulateIntentsMatch)
+}
+
+func printSimulationResult(cmd *cobra.Command, result *services.HeadlessResult) {
+	out := cmd.OutOrStdout()
+	winner := result.WinnerID
+	if winner == "" {
+		winner = "draw"
+	}
+	fmt.Fprintf(out, "Match %s finished after %d ticks, winner: %s\n", result.MatchID, result.Ticks, winner)
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "BOT\tNAME\tKILLS\tDEATHS\tDAMAGE")
+	for _, st := range result.Stats {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.1f\n", st.BotID, st.Name, st.Kills, st.Deaths, st.DamageDealt)
+	}
+	w.Flush()
+}
+
+func init() {
+	simulateCmd.Flags().StringVar(&simulateArenaPath, "arena", "", "Arena YAML or JSON file (default: empty 800x600 arena)")
+	simulateCmd.Flags().StringSliceVar(&simulateBots, "bots", nil, "Bots to join, in order")
+	simulateCmd.Flags().Int64Var(&simulateTicks, "ticks", 10000, "Ticks before the tiebreak decides the match")
+	simulateCmd.Flags().Int64Var(&simulateSeed, "seed", 0, "Match seed (default: the arena's, or random)")
+	simulateCmd.Flags().StringVar(&simulateReplayPath, "replay", "", "SQLite database to record the match to")
+	simulateCmd.Flags().StringVar(&simulateIntentsPath, "intents", "", "SQLite database holding the recorded match for recorded bots")
+	simulateCmd.Flags().StringVar(&simulateIntentsMatch, "intents-match", "", "Match ID of the recorded match")
+	simulateCmd.Flags().StringVar(&simulateClassesPath, "classes", "", "YAML file of bot class stats (default: built-in classes)")
+
+	rootCmd.AddCommand(simulateCmd)
+}
@@ -23,6 +23,7 @@ var (
 	startTickRate     int
 	startRedisAddr    string
 	startDbPath       string
+	startClassesPath  string
 )
 
 var startCmd = &cobra.Command{
@@ -50,6 +51,7 @@ Modes:
 		startArenaHeight = float32(viper.GetFloat64("arena-height"))
 		startTickRate = viper.GetInt("tick-rate")
 		startDbPath = viper.GetString("db-path")
+		startClassesPath = viper.GetString("classes")
 
 		localRuntime, runtimeAddr := determineRuntimeMode(startRuntimeAddr)
 
@@ -82,6 +84,7 @@ Modes:
 				TickRate:     startTickRate,
 				RedisAddr:    startRedisAddr,
 				DBPath:       startDbPath,
+				ClassesPath:  startClassesPath,
 			}
 			if err := core.Start(cfg); err != nil {
 				slog.Error("Engine Failed", "error", err)
@@ -112,6 +115,7 @@ func init() {
 	startCmd.Flags().IntVar(&startTickRate, "tick-rate", 60, "Game loop ticks per second")
 	startCmd.Flags().StringVar(&startRedisAddr, "redis-addr", "localhost:6379", "Redis address for horizontal scaling")
 	startCmd.Flags().StringVar(&startDbPath, "db-path", "codearena.db", "Path to SQLite database file")
+	startCmd.Flags().StringVar(&startClassesPath, "classes", "", "YAML or JSON file with extra bot class sets")
 
 	viper.BindPFlag("grpc-port", startCmd.Flags().Lookup("grpc-port"))
 	viper.BindPFlag("web-port", startCmd.Flags().Lookup("web-port"))
@@ -122,6 +126,7 @@ func init() {
 	viper.BindPFlag("tick-rate", startCmd.Flags().Lookup("tick-rate"))
 	viper.BindPFlag("redis-addr", startCmd.Flags().Lookup("redis-addr"))
 	viper.BindPFlag("db-path", startCmd.Flags().Lookup("db-path"))
+	viper.BindPFlag("classes", startCmd.Flags().Lookup("classes"))
 
 	rootCmd.AddCommand(startCmd)
 }
@@ -10,6 +10,8 @@ import (
 	"syscall"
 	"time"
 
+	// Registers the built-in AIs arena configs can name in builtin_bots
+	_ "github.com/codearena-platform/codearena-core/internal/bots"
 	"github.com/codearena-platform/codearena-core/internal/engine/routes"
 	"github.com/codearena-platform/codearena-core/internal/engine/services"
 	"github.com/codearena-platform/codearena-core/internal/persistence"
@@ -31,6 +33,7 @@ type Config struct {
 	TickRate    int    // Ticks per second
 	RedisAddr   string // Redis address for horizontal scaling
 	DBPath      string // Path to SQLite database
+	ClassesPath string // Optional YAML/JSON file with extra bot class sets
 }
 
 func Start(cfg Config) error {
@@ -50,6 +53,13 @@ func Start(cfg Config) error {
 	}
 	defer db.Close()
 
+	if cfg.ClassesPath != "" {
+		if err := services.DefaultClasses.LoadFile(cfg.ClassesPath); err != nil {
+			return fmt.Errorf("failed to load bot classes: %w", err)
+		}
+		slog.Info("Loaded bot classes", "path", cfg.ClassesPath)
+	}
+
 	// 1. Initialize Simulation Engine
 	// TODO: Pass RuntimeAddr to Engine so it can find the Runtime Service
 	e := services.NewSimulationEngine(cfg.ArenaWidth, cfg.ArenaHeight, db)
@@ -0,0 +1,99 @@
+// Package bots holds the built-in AIs the engine plays itself. They join a
+// match through ArenaConfig.builtin_bots or the simulate command's --bots flag,
+// need no network or container, and make no random choices, so a seeded match
+// against them always plays out the same. Importing the package registers them
+// as in-process controllers.
+package bots
+
+import (
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/codearena-platform/codearena-core/pkg/sdk"
+)
+
+const (
+	SittingDuck = "sitting-duck"
+	Spinner     = "spinner"
+	WallCrawler = "wall-crawler"
+	Tracker     = "tracker"
+	Sniper      = "sniper"
+)
+
+func init() {
+	register(SittingDuck, func() sdk.Bot { return &sittingDuck{} })
+	register(Spinner, func() sdk.Bot { return &spinner{} })
+	register(WallCrawler, func() sdk.Bot { return &wallCrawler{} })
+	register(Tracker, func() sdk.Bot { return &tracker{} })
+	register(Sniper, func() sdk.Bot { return &sniper{} })
+}
+
+// register makes a bot available to the engine by name.
+func register(name string, newBot func() sdk.Bot) {
+	services.RegisterController(name, func() services.BotController {
+		return sdk.NewDriver(newBot())
+	})
+}
+
+// sittingDuck never moves or fires. It is the target for aim and damage
+// regression tests.
+type sittingDuck struct {
+	sdk.BaseBot
+}
+
+const (
+	// lockTimeout is how many ticks a target may go unscanned before the
+	// radar gives up on it and sweeps again.
+	lockTimeout = 5
+	// sweepDegrees turns the radar per tick while searching; no wider than
+	// the narrowest radar field of view (the Sniper's 30), so no bot slips
+	// through the sweep.
+	sweepDegrees = 30
+)
+
+// radarLock keeps the radar pointed at one target once it has been scanned.
+type radarLock struct {
+	scan  *pb.ScannedBotEvent // Latest scan of the target
+	seen  int64               // Tick of the latest scan
+	fresh bool                // The latest scan arrived with this tick
+}
+
+func (r *radarLock) scanned(scan *pb.ScannedBotEvent) {
+	if r.scan == nil || r.scan.TargetId == scan.TargetId {
+		r.scan = scan
+		r.fresh = true
+	}
+}
+
+// update returns the locked target, nil while searching, and the radar turn
+// that keeps the target in view or continues the search.
+func (r *radarLock) update(s *sdk.State, self *pb.BotState) (*pb.ScannedBotEvent, float32) {
+	if r.fresh {
+		r.seen, r.fresh = s.Tick, false
+	}
+	if r.scan != nil && s.Tick-r.seen > lockTimeout {
+		r.scan = nil
+	}
+	if r.scan == nil {
+		return nil, sweepDegrees
+	}
+	return r.scan, clamp(sdk.TurnTo(self.RadarHeading, r.scan.Bearing), sweepDegrees)
+}
+
+// clamp limits a turn to limit degrees either way.
+func clamp(degrees, limit float32) float32 {
+	if degrees > limit {
+		return limit
+	}
+	if degrees < -limit {
+		return -limit
+	}
+	return degrees
+}
+
+// arenaSize returns the arena dimensions from the join handshake.
+func arenaSize(s *sdk.State) (float32, float32) {
+	if s.Arena != nil && s.Arena.Width > 0 && s.Arena.Height > 0 {
+		return s.Arena.Width, s.Arena.Height
+	}
+	return 800, 600
+}
@@ -0,0 +1,100 @@
+package bots
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func arena(seed int64, builtins ...*pb.BuiltinBot) *pb.ArenaConfig {
+	return &pb.ArenaConfig{Width: 800, Height: 600, Seed: seed, BuiltinBots: builtins}
+}
+
+func TestBots_Registered(t *testing.T) {
+	for _, name := range []string{SittingDuck, Spinner, WallCrawler, Tracker, Sniper} {
+		if _, ok := services.LookupController(name); !ok {
+			t.Errorf("Expected %s to be registered", name)
+		}
+	}
+}
+
+func TestBots_BeatSittingDuck(t *testing.T) {
+	for _, name := range []string{Spinner, Tracker, Sniper} {
+		t.Run(name, func(t *testing.T) {
+			result, err := services.RunHeadless(arena(7, &pb.BuiltinBot{Ai: name}, &pb.BuiltinBot{Ai: SittingDuck}), nil, 5000, nil)
+			if err != nil {
+				t.Fatalf("Simulation failed: %v", err)
+			}
+			if result.WinnerID != "builtin_1" {
+				t.Errorf("Expected %s to win, got %q after %d ticks", name, result.WinnerID, result.Ticks)
+			}
+		})
+	}
+}
+
+func TestBots_Deterministic(t *testing.T) {
+	run := func() *services.HeadlessResult {
+		bots := []services.HeadlessBot{}
+		for _, name := range []string{Sniper, WallCrawler, Tracker, Spinner} {
+			factory, _ := services.LookupController(name)
+			bots = append(bots, services.HeadlessBot{Name: name, Controller: factory()})
+		}
+		result, err := services.RunHeadless(arena(42), bots, 3000, nil)
+		if err != nil {
+			t.Fatalf("Simulation failed: %v", err)
+		}
+		return result
+	}
+
+	first, second := run(), run()
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected identical results, got %+v and %+v", first, second)
+	}
+}
+
+func TestWallCrawler_FollowsWalls(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(arena(1, &pb.BuiltinBot{Ai: WallCrawler}, &pb.BuiltinBot{Ai: SittingDuck}))
+	if err := e.JoinBuiltinBots(); err != nil {
+		t.Fatalf("Failed to join builtin bots: %v", err)
+	}
+	e.Status = pb.MatchStatus_RUNNING
+
+	start := e.Bots["builtin_1"].Position
+	travelled := float32(0)
+	last := start
+	for i := 0; i < 600; i++ {
+		e.Tick()
+		p := e.Bots["builtin_1"].Position
+		travelled += float32(math.Hypot(float64(p.X-last.X), float64(p.Y-last.Y)))
+		last = p
+	}
+
+	p := e.Bots["builtin_1"].Position
+	edge := math.Min(math.Min(float64(p.X), float64(800-p.X)), math.Min(float64(p.Y), float64(600-p.Y)))
+	if edge > wallMargin+10 {
+		t.Errorf("Expected the crawler to hug a wall, got %.1f from the nearest one at %v", edge, p)
+	}
+	if travelled < 1000 {
+		t.Errorf("Expected the crawler to keep moving, got %.1f travelled", travelled)
+	}
+}
+
+func TestPredictImpact_LeadsMovingTarget(t *testing.T) {
+	from := &pb.Vector3{X: 100, Y: 300}
+	pos := &pb.Vector3{X: 500, Y: 300}
+	// Driving north at 8 per tick, met by a shell flying at 20
+	target := &pb.ScannedBotEvent{Heading: 0, Velocity: 8}
+	at := predictImpact(from, pos, target, 20, 800, 600)
+
+	flight := math.Hypot(float64(at.X-from.X), float64(at.Y-from.Y)) / 20
+	if travelled := float64(pos.Y - at.Y); math.Abs(travelled-8*flight) > 1 {
+		t.Errorf("Expected the target to travel %.1f before impact, got %.1f", 8*flight, travelled)
+	}
+	if at.X != pos.X {
+		t.Errorf("Expected the impact on the target's path, got %v", at)
+	}
+}
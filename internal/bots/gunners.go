@@ -0,0 +1,105 @@
+package bots
+
+import (
+	"math"
+
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/codearena-platform/codearena-core/pkg/sdk"
+)
+
+const (
+	// trackerRange is the distance the tracker closes to.
+	trackerRange = 150
+	// trackerLongRange is the distance beyond which the tracker saves energy
+	// with light shots.
+	trackerLongRange = 300
+	// aimIterations refines the predicted flight time of a shot.
+	aimIterations = 10
+)
+
+// tracker locks its radar onto the first bot it scans, turns to face it,
+// closes in and fires harder the closer it gets.
+type tracker struct {
+	sdk.BaseBot
+	lock radarLock
+}
+
+func (b *tracker) OnScanned(scan *pb.ScannedBotEvent) { b.lock.scanned(scan) }
+
+func (b *tracker) OnTick(s *sdk.State) *pb.BotIntent {
+	self := s.Self()
+	target, radar := b.lock.update(s, self)
+	intent := sdk.NewIntent().TurnRadar(radar)
+	if target == nil {
+		return intent.Build()
+	}
+
+	aim := sdk.TurnTo(self.GunHeading, target.Bearing)
+	intent.TurnGun(clamp(aim, gunTurn)).Turn(clamp(sdk.TurnTo(self.Heading, target.Bearing), movingTurn))
+	if target.Distance > trackerRange {
+		intent.Move(target.Distance - trackerRange)
+	}
+	if math.Abs(float64(aim)) <= gunTurn && self.Heat == 0 {
+		power := float32(3)
+		if target.Distance > trackerLongRange {
+			power = 1
+		}
+		intent.Fire(power)
+	}
+	return intent.Build()
+}
+
+// sniper stays where it spawned, locks onto a target and fires full power
+// shells at the point where the target will be when the shell arrives,
+// assuming it keeps its heading and speed.
+type sniper struct {
+	sdk.BaseBot
+	lock radarLock
+}
+
+func (b *sniper) OnScanned(scan *pb.ScannedBotEvent) { b.lock.scanned(scan) }
+
+func (b *sniper) OnTick(s *sdk.State) *pb.BotIntent {
+	self := s.Self()
+	target, radar := b.lock.update(s, self)
+	intent := sdk.NewIntent().TurnRadar(radar)
+	if target == nil {
+		return intent.Build()
+	}
+
+	const power = 3
+	width, height := arenaSize(s)
+	// The scan is as old as the ticks since it arrived
+	seen := sdk.Project(self.Position, target.Bearing, target.Distance)
+	now := sdk.Project(seen, target.Heading, target.Velocity*float32(s.Tick-b.lock.seen))
+	at := predictImpact(self.Position, now, target, shellSpeed(power), width, height)
+
+	aim := sdk.TurnTo(self.GunHeading, sdk.Bearing(self.Position, at))
+	intent.TurnGun(clamp(aim, gunTurn))
+	if math.Abs(float64(aim)) <= gunTurn && self.Heat == 0 {
+		intent.Fire(power)
+	}
+	return intent.Build()
+}
+
+// predictImpact returns where a target now at pos meets a shot of the given
+// speed fired from from, kept inside the arena.
+func predictImpact(from, pos *pb.Vector3, target *pb.ScannedBotEvent, speed, width, height float32) *pb.Vector3 {
+	at := pos
+	for i := 0; i < aimIterations; i++ {
+		flight := sdk.Distance(from, at) / speed
+		at = sdk.Project(pos, target.Heading, target.Velocity*flight)
+	}
+	at.X = float32(math.Max(0, math.Min(float64(width), float64(at.X))))
+	at.Y = float32(math.Max(0, math.Min(float64(height), float64(at.Y))))
+	return at
+}
+
+// shellSpeed returns how fast a shell of the given power flies.
+func shellSpeed(power float32) float32 {
+	if def, ok := services.LookupWeapon(pb.WeaponType_SHELL); ok && def.Speed(power) > 0 {
+		return def.Speed(power)
+	}
+	return 20
+}
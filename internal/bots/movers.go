@@ -0,0 +1,129 @@
+package bots
+
+import (
+	"math"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/codearena-platform/codearena-core/pkg/sdk"
+)
+
+const (
+	// gunTurn is a gun turn every class can make in one tick.
+	gunTurn = 15
+	// movingTurn is a body turn every class can make at full speed.
+	movingTurn = 3
+	// standingTurn is a body turn every class can make while nearly stopped.
+	standingTurn = 7
+	// wallMargin is how close the wall crawler gets to a wall.
+	wallMargin = 60
+	// stuckTicks is how long the wall crawler waits behind an obstacle
+	// before it turns away.
+	stuckTicks = 10
+)
+
+// spinner drives in circles with its radar sweeping and snaps shots at every
+// bot the sweep finds.
+type spinner struct {
+	sdk.BaseBot
+	scan *pb.ScannedBotEvent
+}
+
+func (b *spinner) OnScanned(scan *pb.ScannedBotEvent) { b.scan = scan }
+
+func (b *spinner) OnTick(s *sdk.State) *pb.BotIntent {
+	self := s.Self()
+	intent := sdk.NewIntent().Move(100).Turn(movingTurn).TurnRadar(sweepDegrees)
+	if b.scan != nil {
+		aim := sdk.TurnTo(self.GunHeading, b.scan.Bearing)
+		intent.TurnGun(clamp(aim, gunTurn))
+		if math.Abs(float64(aim)) <= gunTurn && self.Heat == 0 {
+			intent.Fire(1)
+		}
+		b.scan = nil
+	}
+	return intent.Build()
+}
+
+// wallCrawler heads for the nearest wall and follows the walls clockwise,
+// with its gun facing into the arena.
+type wallCrawler struct {
+	sdk.BaseBot
+	heading float32 // Wall-following direction, a multiple of 90 degrees
+	started bool
+	last    *pb.Vector3 // Position on the previous tick
+	stuck   int         // Ticks spent without moving while under way
+	scan    *pb.ScannedBotEvent
+}
+
+func (b *wallCrawler) OnScanned(scan *pb.ScannedBotEvent) { b.scan = scan }
+
+func (b *wallCrawler) OnTick(s *sdk.State) *pb.BotIntent {
+	self := s.Self()
+	width, height := arenaSize(s)
+	if !b.started {
+		b.heading = nearestWall(self.Position, width, height)
+		b.started = true
+	}
+
+	aligned := math.Abs(float64(sdk.TurnTo(self.Heading, b.heading))) < 0.5
+	if aligned && b.last != nil && sdk.Distance(b.last, self.Position) < 0.01 {
+		b.stuck++
+	} else {
+		b.stuck = 0
+	}
+	b.last = self.Position
+
+	ahead := wallDistance(self.Position, b.heading, width, height)
+	if ahead <= wallMargin || b.stuck >= stuckTicks {
+		b.heading = sdk.NormalizeAngle(b.heading + 90)
+		b.stuck = 0
+		aligned = false
+	}
+
+	intent := sdk.NewIntent().TurnRadar(sweepDegrees)
+	if aligned {
+		intent.Move(ahead - wallMargin)
+	} else if math.Abs(float64(self.Velocity)) < 1 {
+		intent.Turn(clamp(sdk.TurnTo(self.Heading, b.heading), standingTurn))
+	}
+
+	inward := sdk.NormalizeAngle(b.heading + 90)
+	target := inward
+	if b.scan != nil {
+		target = b.scan.Bearing
+	}
+	aim := sdk.TurnTo(self.GunHeading, target)
+	intent.TurnGun(clamp(aim, gunTurn))
+	if b.scan != nil && math.Abs(float64(aim)) <= gunTurn && self.Heat == 0 {
+		intent.Fire(1)
+	}
+	b.scan = nil
+	return intent.Build()
+}
+
+// nearestWall returns the heading that points straight at the closest wall.
+func nearestWall(p *pb.Vector3, width, height float32) float32 {
+	heading, best := float32(0), p.Y
+	for _, w := range []struct {
+		heading, distance float32
+	}{{90, width - p.X}, {180, height - p.Y}, {270, p.X}} {
+		if w.distance < best {
+			heading, best = w.heading, w.distance
+		}
+	}
+	return heading
+}
+
+// wallDistance returns how far p is from the wall ahead when travelling
+// along a multiple of 90 degrees.
+func wallDistance(p *pb.Vector3, heading, width, height float32) float32 {
+	switch heading {
+	case 90:
+		return width - p.X
+	case 180:
+		return height - p.Y
+	case 270:
+		return p.X
+	}
+	return p.Y
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// authenticateBot validates the join token of a bot and returns the bot ID it
+// grants. Tokens are HMAC-signed JWTs whose subject is the bot ID and whose
+// optional match_id claim restricts the match. When JWT_SECRET is unset, bot
+// authentication is disabled and the requested bot ID is returned as is.
+func authenticateBot(tokenString, botID, matchID string) (string, error) {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		return botID, nil
+	}
+	if tokenString == "" {
+		return "", fmt.Errorf("missing auth token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid auth token: %w", err)
+	}
+
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return "", fmt.Errorf("auth token has no subject")
+	}
+	if botID != "" && botID != subject {
+		return "", fmt.Errorf("auth token is not valid for bot %s", botID)
+	}
+	if tokenMatch, ok := claims["match_id"].(string); ok && tokenMatch != matchID {
+		return "", fmt.Errorf("auth token is not valid for match %s", matchID)
+	}
+	return subject, nil
+}
@@ -3,6 +3,7 @@ package routes
 import (
 	"net/http"
 
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
 	"github.com/gorilla/websocket"
 )
@@ -15,16 +16,17 @@ func (s *SimulationServer) HandleDashboardWS(w http.ResponseWriter, r *http.Requ
 	}
 	defer c.Close()
 
-	ch := make(chan *pb.WorldState, 100)
-	s.mu.Lock()
-	s.dashboardChannels[ch] = true
-	s.mu.Unlock()
+	matchID := r.URL.Query().Get("match_id")
+	if matchID == "" {
+		matchID = services.DefaultMatchID
+	}
 
-	defer func() {
-		s.mu.Lock()
-		delete(s.dashboardChannels, ch)
-		s.mu.Unlock()
-	}()
+	ch := make(chan *pb.WorldState, 100)
+	if err := s.addDashboardChannel(matchID, ch); err != nil {
+		c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, err.Error()))
+		return
+	}
+	defer s.removeDashboardChannel(matchID, ch)
 
 	for st := range ch {
 		if err := c.WriteJSON(st); err != nil {
@@ -1,68 +1,233 @@
 package routes
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// MatchIDMetadataKey is the gRPC metadata key bots use to pick the match they join.
+const MatchIDMetadataKey = "match-id"
+
+// ProtocolVersion is the newest bot protocol version the server speaks.
+const ProtocolVersion = 1
+
+// matchIDFromContext reads the target match from the incoming gRPC metadata,
+// falling back to the default match.
+func matchIDFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(MatchIDMetadataKey); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	return services.DefaultMatchID
+}
+
+// Connect runs a bot session. The first message should carry a JoinRequest;
+// legacy bots that start with a plain intent join anonymously as a Tank. A
+// join with the session token of a dropped stream resumes that bot.
 func (s *SimulationServer) Connect(stream pb.BotService_ConnectServer) error {
-	if _, err := stream.Recv(); err != nil {
+	first, err := stream.Recv()
+	if err != nil {
 		return err
 	}
-	botID := fmt.Sprintf("bot_%d", time.Now().UnixNano())
-	log.Printf("Bot %s connected", botID)
 
-	s.mu.Lock()
-	count := len(s.botChannels)
-	posX, posY := float32(100), float32(100)
-	if count == 1 {
-		posX, posY = 600, 400
+	join := first.GetJoin()
+	if join == nil {
+		join = &pb.JoinRequest{}
+	}
+	if join.MatchId == "" {
+		join.MatchId = matchIDFromContext(stream.Context())
 	}
-	s.mu.Unlock()
-
-	s.engine.SetBot(botID, &pb.BotState{
-		Id: botID, Name: botID, Position: &pb.Vector3{X: posX, Y: posY}, Hull: 100, Energy: 150,
-	})
 
-	out := make(chan *pb.WorldState, 10)
-	s.mu.Lock()
-	s.botChannels[botID] = out
-	s.mu.Unlock()
+	m, bot, out, resumed, err := s.admitBot(join)
+	if err != nil {
+		log.Printf("Bot rejected from match %s: %v", join.MatchId, err)
+		return err
+	}
+	matchID := m.ID
+	token := join.SessionToken
+	if resumed {
+		log.Printf("Bot %s reconnected to match %s", bot.Id, matchID)
+	} else {
+		token = m.Engine.OpenSession(bot.Id)
+		log.Printf("Bot %s (%s, version %q) connected to match %s", bot.Id, bot.Class, join.BotVersion, matchID)
+	}
 
 	defer func() {
 		s.mu.Lock()
-		delete(s.botChannels, botID)
-		s.mu.Unlock()
+		defer s.mu.Unlock()
+		// The channel is gone already when the match finished. The drop is
+		// recorded under the lock so a resume cannot be queued before it.
+		_, open := s.botChannels[matchID][bot.Id]
+		delete(s.botChannels[matchID], bot.Id)
+		if open {
+			m.Engine.Disconnect(bot.Id)
+			log.Printf("Bot %s disconnected from match %s", bot.Id, matchID)
+		}
 	}()
 
+	// Answer the handshake with the arena and the bot's initial view
+	initial := m.Engine.StateForBot(bot.Id)
+	initial.JoinAccepted = &pb.JoinAccepted{
+		BotId:           bot.Id,
+		MatchId:         matchID,
+		Arena:           m.Engine.ArenaConfig,
+		ProtocolVersion: ProtocolVersion,
+		SessionToken:    token,
+		Resumed:         resumed,
+	}
+	if err := stream.Send(initial); err != nil {
+		return err
+	}
+
 	go func() {
 		for {
 			in, err := stream.Recv()
 			if err != nil {
 				return
 			}
-			s.engine.SetBotIntent(botID, in)
+			m.Engine.SetBotIntent(bot.Id, in)
 		}
 	}()
 
-	for st := range out {
-		if err := stream.Send(st); err != nil {
-			return err
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case st, ok := <-out:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(st); err != nil {
+				return err
+			}
 		}
 	}
-	return nil
 }
 
-func (s *SimulationServer) BroadcastState(st *pb.WorldState) {
+// admitBot validates a join request against the match and registers the bot
+// and its state channel. It reports whether the join resumed a session.
+func (s *SimulationServer) admitBot(join *pb.JoinRequest) (*services.Match, *pb.BotState, chan *pb.WorldState, bool, error) {
+	if join.ProtocolVersion > ProtocolVersion {
+		return nil, nil, nil, false, status.Errorf(codes.FailedPrecondition, "protocol version %d is not supported (max %d)", join.ProtocolVersion, ProtocolVersion)
+	}
+
+	botID, err := authenticateBot(join.AuthToken, join.BotId, join.MatchId)
+	if err != nil {
+		return nil, nil, nil, false, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Checked under the lock so a concurrent teardown cannot miss this channel
+	m, ok := s.matches.Get(join.MatchId)
+	if !ok {
+		return nil, nil, nil, false, status.Errorf(codes.NotFound, "match %s not found", join.MatchId)
+	}
+	if m.Engine.GetStatus() == pb.MatchStatus_FINISHED {
+		return nil, nil, nil, false, status.Errorf(codes.FailedPrecondition, "match %s is finished", join.MatchId)
+	}
+	if _, taken := s.botChannels[m.ID][botID]; taken {
+		return nil, nil, nil, false, status.Errorf(codes.AlreadyExists, "bot %s is already connected", botID)
+	}
+
+	var bot *pb.BotState
+	resumed := join.SessionToken != ""
+	if resumed {
+		// Only a bot whose old stream is gone may resume
+		owner, ok := m.Engine.SessionBot(join.SessionToken)
+		if !ok {
+			return nil, nil, nil, false, status.Error(codes.NotFound, "unknown session token")
+		}
+		if botID != "" && botID != owner {
+			return nil, nil, nil, false, status.Errorf(codes.PermissionDenied, "session belongs to bot %s", owner)
+		}
+		if _, taken := s.botChannels[m.ID][owner]; taken {
+			return nil, nil, nil, false, status.Errorf(codes.AlreadyExists, "bot %s is already connected", owner)
+		}
+		if bot, err = m.Engine.ResumeSession(join.SessionToken); err != nil {
+			return nil, nil, nil, false, status.Error(codes.NotFound, err.Error())
+		}
+	} else {
+		if bot, err = s.joinBot(m, join, botID); err != nil {
+			return nil, nil, nil, false, err
+		}
+	}
+
+	out := make(chan *pb.WorldState, 10)
+	if s.botChannels[m.ID] == nil {
+		s.botChannels[m.ID] = make(map[string]chan *pb.WorldState)
+	}
+	s.botChannels[m.ID][bot.Id] = out
+	return m, bot, out, resumed, nil
+}
+
+// joinBot adds a new bot to a match. Bots already in the match, connected or
+// not, can only come back by resuming their session. The caller must hold
+// s.mu.
+func (s *SimulationServer) joinBot(m *services.Match, join *pb.JoinRequest, botID string) (*pb.BotState, error) {
+	if botID != "" && m.Engine.HasJoined(botID) {
+		return nil, status.Errorf(codes.AlreadyExists, "bot %s is already in match %s; resume it with its session token", botID, m.ID)
+	}
+
+	class := join.Class
+	if class == "" {
+		class = services.ClassTank
+	}
+	if _, ok := m.Engine.Class(class); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown bot class %q", class)
+	}
+
+	// Disconnected bots keep their place until they forfeit
+	if maxBots := m.Engine.ArenaConfig.MaxBots; maxBots > 0 && m.Engine.JoinedCount() >= int(maxBots) {
+		return nil, status.Errorf(codes.ResourceExhausted, "match %s is full (%d bots)", m.ID, maxBots)
+	}
+
+	// Anonymous bots are numbered in join order so replays get the same IDs
+	for n := 1; botID == ""; n++ {
+		if id := fmt.Sprintf("bot_%d", n); !m.Engine.HasJoined(id) {
+			botID = id
+		}
+	}
+	bot, err := m.Engine.Join(botID, join.Name, class, join.TeamId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return bot, nil
+}
+
+// broadcaster returns the GameLoop broadcast callback for a match. Once the
+// match finishes, it is removed from the registry and its streams are closed.
+func (s *SimulationServer) broadcaster(matchID string) func(*pb.WorldState) {
+	return func(st *pb.WorldState) {
+		s.BroadcastState(matchID, st)
+		if st.Status == pb.MatchStatus_FINISHED {
+			s.matches.Remove(matchID)
+			s.closeMatchChannels(matchID)
+		}
+	}
+}
+
+func (s *SimulationServer) BroadcastState(matchID string, st *pb.WorldState) {
+	m, ok := s.matches.Get(matchID)
+	if !ok {
+		return
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	// 1. Broadcast to Bots (Filtered)
-	for botID, ch := range s.botChannels {
-		filteredState := s.engine.Physics.FilterStateForBot(botID, st)
+	for botID, ch := range s.botChannels[matchID] {
+		filteredState := m.Engine.Physics.FilterStateForBot(botID, st)
 		select {
 		case ch <- filteredState:
 		default:
@@ -70,10 +235,47 @@ func (s *SimulationServer) BroadcastState(st *pb.WorldState) {
 	}
 
 	// 2. Broadcast to Dashboards (Full State)
-	for ch := range s.dashboardChannels {
+	for ch := range s.dashboardChannels[matchID] {
 		select {
 		case ch <- st:
 		default:
 		}
 	}
 }
+
+// closeMatchChannels ends every bot and dashboard stream attached to a match.
+func (s *SimulationServer) closeMatchChannels(matchID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ch := range s.botChannels[matchID] {
+		close(ch)
+	}
+	delete(s.botChannels, matchID)
+
+	for ch := range s.dashboardChannels[matchID] {
+		close(ch)
+	}
+	delete(s.dashboardChannels, matchID)
+}
+
+// addDashboardChannel subscribes ch to the full state of a match.
+func (s *SimulationServer) addDashboardChannel(matchID string, ch chan *pb.WorldState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.matches.Get(matchID); !ok {
+		return fmt.Errorf("match %s not found", matchID)
+	}
+	if s.dashboardChannels[matchID] == nil {
+		s.dashboardChannels[matchID] = make(map[chan *pb.WorldState]bool)
+	}
+	s.dashboardChannels[matchID][ch] = true
+	return nil
+}
+
+func (s *SimulationServer) removeDashboardChannel(matchID string, ch chan *pb.WorldState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.dashboardChannels[matchID], ch)
+}
@@ -0,0 +1,250 @@
+package routes
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startTestServer serves s over an in-memory listener and returns a connected client.
+func startTestServer(t *testing.T, s *SimulationServer) pb.BotServiceClient {
+	lis := bufconn.Listen(1 << 20)
+	grpcSrv := grpc.NewServer()
+	pb.RegisterBotServiceServer(grpcSrv, s)
+	go grpcSrv.Serve(lis)
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewBotServiceClient(conn)
+}
+
+func join(t *testing.T, client pb.BotServiceClient, req *pb.JoinRequest) (*pb.WorldState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	stream, err := client.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pb.BotIntent{Join: req}); err != nil {
+		return nil, err
+	}
+	return stream.Recv()
+}
+
+func TestConnect_Handshake(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	e.ArenaConfig.MaxBots = 2
+	s := NewSimulationServer(e, time.Hour)
+	client := startTestServer(t, s)
+
+	st, err := join(t, client, &pb.JoinRequest{BotId: "scout-1", Class: services.ClassScout, TeamId: "red", ProtocolVersion: ProtocolVersion})
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	accepted := st.JoinAccepted
+	if accepted == nil || accepted.BotId != "scout-1" || accepted.MatchId != services.DefaultMatchID {
+		t.Fatalf("Unexpected JoinAccepted: %v", accepted)
+	}
+	if accepted.Arena.GetWidth() != 800 {
+		t.Errorf("Expected arena config in JoinAccepted, got %v", accepted.Arena)
+	}
+	if len(st.Bots) != 1 || st.Bots[0].Class != services.ClassScout || st.Bots[0].TeamId != "red" {
+		t.Errorf("Expected initial state with the scout, got %v", st.Bots)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.JoinRequest
+		code codes.Code
+	}{
+		{name: "Duplicate bot", req: &pb.JoinRequest{BotId: "scout-1"}, code: codes.AlreadyExists},
+		{name: "Unknown class", req: &pb.JoinRequest{Class: "Mech"}, code: codes.InvalidArgument},
+		{name: "Future protocol", req: &pb.JoinRequest{ProtocolVersion: ProtocolVersion + 1}, code: codes.FailedPrecondition},
+		{name: "Unknown match", req: &pb.JoinRequest{MatchId: "nope"}, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := join(t, client, tt.req)
+			if status.Code(err) != tt.code {
+				t.Errorf("expected %s, got %v", tt.code, err)
+			}
+		})
+	}
+
+	if _, err := join(t, client, &pb.JoinRequest{BotId: "tank-1"}); err != nil {
+		t.Fatalf("Second bot should fit: %v", err)
+	}
+	if _, err := join(t, client, &pb.JoinRequest{BotId: "tank-2"}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected full match to be rejected, got %v", err)
+	}
+}
+
+func TestConnect_AuthToken(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	defer os.Unsetenv("JWT_SECRET")
+
+	e := services.NewSimulationEngine(800, 600, nil)
+	client := startTestServer(t, NewSimulationServer(e, time.Hour))
+
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "bot-a"}).SignedString([]byte("test-secret"))
+
+	if _, err := join(t, client, &pb.JoinRequest{BotId: "bot-a"}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected missing token to be rejected, got %v", err)
+	}
+	if _, err := join(t, client, &pb.JoinRequest{BotId: "bot-b", AuthToken: token}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected token for another bot to be rejected, got %v", err)
+	}
+
+	st, err := join(t, client, &pb.JoinRequest{AuthToken: token})
+	if err != nil {
+		t.Fatalf("Valid token rejected: %v", err)
+	}
+	if st.JoinAccepted.BotId != "bot-a" {
+		t.Errorf("Expected bot ID from token subject, got %q", st.JoinAccepted.BotId)
+	}
+}
+
+func TestConnect_ResumeSession(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	s := NewSimulationServer(e, time.Hour)
+	client := startTestServer(t, s)
+
+	ctx, drop := context.WithCancel(context.Background())
+	stream, err := client.Connect(ctx)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	stream.Send(&pb.BotIntent{Join: &pb.JoinRequest{Class: services.ClassScout}})
+	st, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	first := st.JoinAccepted
+	if first.SessionToken == "" || first.Resumed {
+		t.Fatalf("Expected a new session with a token, got %v", first)
+	}
+	drop()
+
+	// Retry until the server has noticed the old stream dropping
+	var resumed *pb.JoinAccepted
+	for deadline := time.Now().Add(5 * time.Second); resumed == nil && time.Now().Before(deadline); {
+		st, err := join(t, client, &pb.JoinRequest{SessionToken: first.SessionToken})
+		if err == nil {
+			resumed = st.JoinAccepted
+		} else if status.Code(err) != codes.AlreadyExists {
+			t.Fatalf("Resume failed: %v", err)
+		}
+	}
+	if resumed == nil || !resumed.Resumed || resumed.BotId != first.BotId || resumed.SessionToken != first.SessionToken {
+		t.Fatalf("Expected to resume %s, got %v", first.BotId, resumed)
+	}
+	if bots := e.GetBotSlice(); len(bots) != 1 || bots[0].Class != services.ClassScout {
+		t.Errorf("Expected the original bot to be kept, got %v", bots)
+	}
+
+	state := e.Tick()
+	var disconnected, reconnected bool
+	for _, ev := range state.Events {
+		disconnected = disconnected || ev.GetBotDisconnected().GetBotId() == first.BotId
+		reconnected = reconnected || ev.GetBotReconnected().GetBotId() == first.BotId
+	}
+	if !disconnected || !reconnected {
+		t.Errorf("Expected disconnect and reconnect events, got %v", state.Events)
+	}
+
+	if _, err := join(t, client, &pb.JoinRequest{SessionToken: "bogus"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected an unknown token to be rejected, got %v", err)
+	}
+}
+
+func TestConnect_RejoinNeedsSession(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	client := startTestServer(t, NewSimulationServer(e, time.Hour))
+
+	e.Join("ghost", "", "", "")
+	if _, err := join(t, client, &pb.JoinRequest{BotId: "ghost"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Expected a rejoin without a session to be rejected, got %v", err)
+	}
+
+	// Anonymous bots skip IDs that were handed out before
+	e.Join("bot_1", "", "", "")
+	st, err := join(t, client, &pb.JoinRequest{})
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	if st.JoinAccepted.BotId != "bot_2" {
+		t.Errorf("Expected bot_2, got %s", st.JoinAccepted.BotId)
+	}
+}
+
+func TestConnect_FullWithDisconnectedBot(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, MaxBots: 1})
+	client := startTestServer(t, NewSimulationServer(e, time.Hour))
+
+	ctx, drop := context.WithCancel(context.Background())
+	stream, err := client.Connect(ctx)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	stream.Send(&pb.BotIntent{Join: &pb.JoinRequest{}})
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	drop()
+	start := time.Now()
+
+	// Wait for the server to notice the drop
+	for dropped := false; !dropped; {
+		if time.Since(start) > 5*time.Second {
+			t.Fatal("Timed out waiting for the bot to disconnect")
+		}
+		for _, ev := range e.Tick().Events {
+			dropped = dropped || ev.GetBotDisconnected() != nil
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// The dropped bot is in its grace period and still holds the only place
+	if _, err := join(t, client, &pb.JoinRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected the match to stay full while the dropped bot may resume, got %v", err)
+	}
+}
+
+func TestConnect_JoinWhileTicking(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	s := NewSimulationServer(e, time.Millisecond)
+	client := startTestServer(t, s)
+	ctx := context.Background()
+	s.StartSimulation(ctx, &pb.ArenaConfig{})
+	defer s.StopSimulation(ctx, &pb.StopSimulationRequest{})
+
+	// Run with -race: the handshake must not read the engine while it ticks
+	for i := 0; i < 5; i++ {
+		st, err := join(t, client, &pb.JoinRequest{})
+		if err != nil {
+			t.Fatalf("Join failed: %v", err)
+		}
+		if st.JoinAccepted == nil {
+			t.Fatalf("Expected a handshake, got %v", st)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+}
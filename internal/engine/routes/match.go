@@ -3,59 +3,235 @@ package routes
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	"github.com/codearena-platform/codearena-core/internal/persistence"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func (s *SimulationServer) StartSimulation(ctx context.Context, cfg *pb.ArenaConfig) (*pb.SimulationResponse, error) {
-	loop := services.NewGameLoop(s.engine, s.TickRate, s.BroadcastState)
-	loop.Start()
+	matchID := cfg.Id
+	if matchID == "" {
+		matchID = services.DefaultMatchID
+	}
+
+	// Unknown IDs get a fresh engine so several matches can run side by side
+	if _, ok := s.matches.Get(matchID); !ok {
+		e, err := s.newEngine(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.matches.Add(matchID, e); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := s.matches.Start(matchID, s.TickRate, s.broadcaster(matchID)); err != nil {
+		return nil, err
+	}
+	log.Printf("Match %s started", matchID)
 
 	return &pb.SimulationResponse{Status: pb.MatchStatus_RUNNING}, nil
 }
 
 func (s *SimulationServer) StopSimulation(ctx context.Context, req *pb.StopSimulationRequest) (*pb.SimulationResponse, error) {
-	return &pb.SimulationResponse{Status: pb.MatchStatus_FINISHED}, nil
+	matchID := req.MatchId
+	if matchID == "" {
+		matchID = services.DefaultMatchID
+	}
+
+	m, err := s.matches.Stop(matchID)
+	if err != nil {
+		return nil, err
+	}
+	s.closeMatchChannels(matchID)
+	log.Printf("Match %s stopped", matchID)
+
+	return &pb.SimulationResponse{Status: pb.MatchStatus_FINISHED, Tick: m.Engine.CurrentTick}, nil
+}
+
+// PauseSimulation freezes a running match until it is resumed or stepped.
+func (s *SimulationServer) PauseSimulation(ctx context.Context, req *pb.MatchRequest) (*pb.SimulationResponse, error) {
+	loop, err := s.matches.Loop(matchIDOrDefault(req.MatchId))
+	if err != nil {
+		return nil, err
+	}
+	st, err := loop.Pause()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Match %s paused at tick %d", loop.Engine.MatchID, st.Tick)
+	return loopResponse(loop, st), nil
+}
+
+// ResumeSimulation lets a paused match run again.
+func (s *SimulationServer) ResumeSimulation(ctx context.Context, req *pb.MatchRequest) (*pb.SimulationResponse, error) {
+	loop, err := s.matches.Loop(matchIDOrDefault(req.MatchId))
+	if err != nil {
+		return nil, err
+	}
+	st, err := loop.Resume()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Match %s resumed at tick %d", loop.Engine.MatchID, st.Tick)
+	return loopResponse(loop, st), nil
+}
+
+// StepSimulation advances a paused match by the requested number of ticks.
+func (s *SimulationServer) StepSimulation(ctx context.Context, req *pb.StepSimulationRequest) (*pb.SimulationResponse, error) {
+	loop, err := s.matches.Loop(matchIDOrDefault(req.MatchId))
+	if err != nil {
+		return nil, err
+	}
+	ticks := int(req.Ticks)
+	if ticks == 0 {
+		ticks = 1
+	}
+	st, err := loop.Step(ticks)
+	if err != nil {
+		return nil, err
+	}
+	return loopResponse(loop, st), nil
+}
+
+// SetTickRate changes how many ticks per second a match runs at.
+func (s *SimulationServer) SetTickRate(ctx context.Context, req *pb.SetTickRateRequest) (*pb.SimulationResponse, error) {
+	loop, err := s.matches.Loop(matchIDOrDefault(req.MatchId))
+	if err != nil {
+		return nil, err
+	}
+	if req.TicksPerSecond <= 0 || req.TicksPerSecond > services.MaxTicksPerSecond {
+		return nil, fmt.Errorf("ticks_per_second must be greater than 0 and at most %d, got %g", services.MaxTicksPerSecond, req.TicksPerSecond)
+	}
+	st, err := loop.SetTickRate(time.Duration(float64(time.Second) / float64(req.TicksPerSecond)))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Match %s running at %g ticks per second", loop.Engine.MatchID, req.TicksPerSecond)
+	return loopResponse(loop, st), nil
+}
+
+func matchIDOrDefault(id string) string {
+	if id == "" {
+		return services.DefaultMatchID
+	}
+	return id
+}
+
+// loopResponse reports the status and tick of st, a state taken by a loop
+// control, and the speed of the match's game loop.
+func loopResponse(loop *services.GameLoop, st *pb.WorldState) *pb.SimulationResponse {
+	return &pb.SimulationResponse{
+		Status:         st.Status,
+		Tick:           st.Tick,
+		TicksPerSecond: float32(time.Second) / float32(loop.Rate()),
+	}
+}
+
+// CreateMatch validates cfg and registers a WAITING match that bots can join
+// before it is started with StartSimulation.
+func (s *SimulationServer) CreateMatch(ctx context.Context, cfg *pb.ArenaConfig) (*pb.MatchResponse, error) {
+	matchID := cfg.Id
+	if matchID == "" {
+		matchID = fmt.Sprintf("match_%d", time.Now().UnixNano())
+	}
+
+	e, err := s.newEngine(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.matches.Add(matchID, e); err != nil {
+		return nil, err
+	}
+	e.ArenaConfig.Id = matchID
+
+	if s.db != nil {
+		err := s.db.CreateMatch(&persistence.Match{
+			ID:          matchID,
+			Status:      "WAITING",
+			ArenaWidth:  e.ArenaConfig.Width,
+			ArenaHeight: e.ArenaConfig.Height,
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			s.matches.Remove(matchID)
+			return nil, fmt.Errorf("failed to persist match: %w", err)
+		}
+	}
+	log.Printf("Match %s created", matchID)
+
+	return &pb.MatchResponse{MatchId: matchID, Status: pb.MatchStatus_WAITING}, nil
+}
+
+// newEngine builds an engine for cfg, falling back to the default arena
+// dimensions when none are given, and rejects invalid configurations. The
+// arena's builtin bots join straight away.
+func (s *SimulationServer) newEngine(cfg *pb.ArenaConfig) (*services.SimulationEngine, error) {
+	arena := proto.Clone(cfg).(*pb.ArenaConfig)
+	if arena.Width == 0 && arena.Height == 0 {
+		arena.Width, arena.Height = s.defaultArena.Width, s.defaultArena.Height
+	}
+	if err := services.ValidateArenaConfig(arena); err != nil {
+		return nil, fmt.Errorf("invalid arena config: %w", err)
+	}
+
+	e := services.NewSimulationEngine(arena.Width, arena.Height, s.db)
+	if err := e.SetArenaConfig(arena); err != nil {
+		return nil, fmt.Errorf("invalid arena config: %w", err)
+	}
+	if err := e.JoinBuiltinBots(); err != nil {
+		return nil, err
+	}
+	return e, nil
 }
 
 func (s *SimulationServer) WatchMatch(req *pb.MatchRequest, stream pb.MatchService_WatchMatchServer) error {
+	matchID := req.MatchId
+	if matchID == "" {
+		matchID = services.DefaultMatchID
+	}
+
 	out := make(chan *pb.WorldState, 10)
-	s.mu.Lock()
-	s.dashboardChannels[out] = true
-	s.mu.Unlock()
-
-	defer func() {
-		s.mu.Lock()
-		delete(s.dashboardChannels, out)
-		s.mu.Unlock()
-	}()
-
-	for st := range out {
-		if err := stream.Send(st); err != nil {
-			return err
+	if err := s.addDashboardChannel(matchID, out); err != nil {
+		return err
+	}
+	defer s.removeDashboardChannel(matchID, out)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case st, ok := <-out:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(st); err != nil {
+				return err
+			}
 		}
 	}
-	return nil
 }
 
 func (s *SimulationServer) ListActiveMatches(ctx context.Context, req *pb.Empty) (*pb.MatchList, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	list := &pb.MatchList{}
-	if s.engine.Status == pb.MatchStatus_RUNNING {
-		list.Matches = append(list.Matches, &pb.MatchResponse{
-			MatchId: s.engine.MatchID,
-			Status:  pb.MatchStatus_RUNNING,
-		})
+	for _, m := range s.matches.List() {
+		if status := m.Engine.GetStatus(); status == pb.MatchStatus_RUNNING || status == pb.MatchStatus_PAUSED {
+			list.Matches = append(list.Matches, &pb.MatchResponse{
+				MatchId: m.ID,
+				Status:  status,
+			})
+		}
 	}
 	return list, nil
 }
 
 func (s *SimulationServer) ListMatches(ctx context.Context, req *pb.Empty) (*pb.MatchList, error) {
-	db := s.engine.DB
+	db := s.db
 	if db == nil {
 		return &pb.MatchList{}, nil
 	}
@@ -68,7 +244,10 @@ func (s *SimulationServer) ListMatches(ctx context.Context, req *pb.Empty) (*pb.
 	list := &pb.MatchList{}
 	for _, m := range matches {
 		status := pb.MatchStatus_FINISHED
-		if m.Status == "RUNNING" {
+		switch m.Status {
+		case "WAITING":
+			status = pb.MatchStatus_WAITING
+		case "RUNNING":
 			status = pb.MatchStatus_RUNNING
 		}
 		list.Matches = append(list.Matches, &pb.MatchResponse{
@@ -80,7 +259,7 @@ func (s *SimulationServer) ListMatches(ctx context.Context, req *pb.Empty) (*pb.
 }
 
 func (s *SimulationServer) GetMatchReplay(ctx context.Context, req *pb.ReplayRequest) (*pb.ReplayData, error) {
-	db := s.engine.DB
+	db := s.db
 	if db == nil {
 		return nil, nil
 	}
@@ -98,14 +277,25 @@ func (s *SimulationServer) GetMatchReplay(ctx context.Context, req *pb.ReplayReq
 		}
 	}
 
-	return &pb.ReplayData{
+	replay := &pb.ReplayData{
 		MatchId: req.MatchId,
 		Events:  pbEvents,
-	}, nil
+	}
+	if req.IncludeStates {
+		frames, err := db.GetStateFrames(req.MatchId, req.StartTick, req.EndTick)
+		if err != nil {
+			return nil, err
+		}
+		replay.States, err = services.ReplayStates(frames, req.StartTick, req.EndTick)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return replay, nil
 }
 
 func (s *SimulationServer) GetMatchHighlights(ctx context.Context, req *pb.ReplayRequest) (*pb.HighlightsData, error) {
-	db := s.engine.DB
+	db := s.db
 	if db == nil {
 		return nil, nil
 	}
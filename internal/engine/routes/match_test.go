@@ -3,6 +3,7 @@ package routes
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/codearena-platform/codearena-core/internal/engine/services"
 	"github.com/codearena-platform/codearena-core/internal/persistence"
@@ -35,3 +36,154 @@ func TestSimulationServer_GetMatchHighlights(t *testing.T) {
 		t.Errorf("Unexpected description: %s", resp.Moments[0].Description)
 	}
 }
+
+func TestSimulationServer_MultipleMatches(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	s := NewSimulationServer(e, time.Hour)
+	ctx := context.Background()
+
+	for _, id := range []string{"bracket-1", "bracket-2"} {
+		if _, err := s.StartSimulation(ctx, &pb.ArenaConfig{Id: id}); err != nil {
+			t.Fatalf("Failed to start %s: %v", id, err)
+		}
+	}
+
+	active, _ := s.ListActiveMatches(ctx, &pb.Empty{})
+	if len(active.Matches) != 2 {
+		t.Fatalf("Expected 2 active matches, got %d", len(active.Matches))
+	}
+
+	m, _ := s.matches.Get("bracket-1")
+	if m.Engine.ArenaConfig.Width != 800 {
+		t.Errorf("Expected default arena width for new match, got %f", m.Engine.ArenaConfig.Width)
+	}
+
+	if _, err := s.StopSimulation(ctx, &pb.StopSimulationRequest{MatchId: "bracket-1"}); err != nil {
+		t.Fatalf("Failed to stop bracket-1: %v", err)
+	}
+
+	active, _ = s.ListActiveMatches(ctx, &pb.Empty{})
+	if len(active.Matches) != 1 || active.Matches[0].MatchId != "bracket-2" {
+		t.Errorf("Expected only bracket-2 to remain active, got %v", active.Matches)
+	}
+
+	if _, err := s.StopSimulation(ctx, &pb.StopSimulationRequest{MatchId: "unknown"}); err == nil {
+		t.Error("Expected error when stopping an unknown match")
+	}
+	s.StopSimulation(ctx, &pb.StopSimulationRequest{MatchId: "bracket-2"})
+}
+
+func TestSimulationServer_CreateMatch(t *testing.T) {
+	db, _ := persistence.NewDatabase(":memory:")
+	e := services.NewSimulationEngine(800, 600, db)
+	s := NewSimulationServer(e, time.Hour)
+	ctx := context.Background()
+
+	resp, err := s.CreateMatch(ctx, &pb.ArenaConfig{Width: 1000, Height: 800, MaxBots: 2})
+	if err != nil {
+		t.Fatalf("Failed to create match: %v", err)
+	}
+	if resp.MatchId == "" || resp.Status != pb.MatchStatus_WAITING {
+		t.Fatalf("Unexpected response: %v", resp)
+	}
+
+	m, ok := s.matches.Get(resp.MatchId)
+	if !ok {
+		t.Fatal("Created match not registered")
+	}
+	if m.Engine.ArenaConfig.Width != 1000 {
+		t.Errorf("Expected arena width 1000, got %f", m.Engine.ArenaConfig.Width)
+	}
+
+	list, _ := s.ListMatches(ctx, &pb.Empty{})
+	if len(list.Matches) != 1 || list.Matches[0].Status != pb.MatchStatus_WAITING {
+		t.Errorf("Expected persisted WAITING match, got %v", list.Matches)
+	}
+	saved, _ := db.ListMatches()
+	created := saved[0].CreatedAt
+
+	if _, err := s.StartSimulation(ctx, &pb.ArenaConfig{Id: resp.MatchId}); err != nil {
+		t.Fatalf("Failed to start match: %v", err)
+	}
+	list, _ = s.ListMatches(ctx, &pb.Empty{})
+	if len(list.Matches) != 1 || list.Matches[0].Status != pb.MatchStatus_RUNNING {
+		t.Errorf("Expected the started match to be RUNNING, got %v", list.Matches)
+	}
+	if _, err := s.StopSimulation(ctx, &pb.StopSimulationRequest{MatchId: resp.MatchId}); err != nil {
+		t.Fatalf("Failed to stop match: %v", err)
+	}
+	saved, _ = db.ListMatches()
+	if len(saved) != 1 || saved[0].Status != "FINISHED" || !saved[0].CreatedAt.Equal(created) {
+		t.Errorf("Expected the result to keep the creation time %v, got %+v", created, saved)
+	}
+
+	if _, err := s.CreateMatch(ctx, &pb.ArenaConfig{Width: 800, Height: 600, MaxBots: -1}); err == nil {
+		t.Error("Expected invalid config to be rejected")
+	}
+}
+
+func TestSimulationServer_PauseAndStep(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	s := NewSimulationServer(e, time.Hour)
+	ctx := context.Background()
+
+	if _, err := s.PauseSimulation(ctx, &pb.MatchRequest{}); err == nil {
+		t.Error("Expected pausing a match that has not started to fail")
+	}
+	s.StartSimulation(ctx, &pb.ArenaConfig{})
+
+	resp, err := s.PauseSimulation(ctx, &pb.MatchRequest{})
+	if err != nil || resp.Status != pb.MatchStatus_PAUSED {
+		t.Fatalf("Expected a PAUSED match, got %v (%v)", resp, err)
+	}
+	active, _ := s.ListActiveMatches(ctx, &pb.Empty{})
+	if len(active.Matches) != 1 || active.Matches[0].Status != pb.MatchStatus_PAUSED {
+		t.Errorf("Expected the paused match to stay active, got %v", active.Matches)
+	}
+
+	resp, err = s.StepSimulation(ctx, &pb.StepSimulationRequest{Ticks: 5})
+	if err != nil || resp.Tick != 5 || resp.Status != pb.MatchStatus_PAUSED {
+		t.Errorf("Expected tick 5 while paused, got %v (%v)", resp, err)
+	}
+
+	if _, err := s.SetTickRate(ctx, &pb.SetTickRateRequest{TicksPerSecond: -1}); err == nil {
+		t.Error("Expected a negative tick rate to be rejected")
+	}
+	resp, err = s.SetTickRate(ctx, &pb.SetTickRateRequest{TicksPerSecond: 10})
+	if err != nil || resp.TicksPerSecond != 10 {
+		t.Errorf("Expected 10 ticks per second, got %v (%v)", resp, err)
+	}
+
+	resp, err = s.StopSimulation(ctx, &pb.StopSimulationRequest{})
+	if err != nil || resp.Status != pb.MatchStatus_FINISHED || e.Status != pb.MatchStatus_FINISHED {
+		t.Errorf("Expected the paused match to finish, got %v (%v)", resp, err)
+	}
+}
+
+func TestSimulationServer_ControlsWhileTicking(t *testing.T) {
+	e := services.NewSimulationEngine(800, 600, nil)
+	s := NewSimulationServer(e, time.Millisecond)
+	ctx := context.Background()
+	s.StartSimulation(ctx, &pb.ArenaConfig{})
+	defer s.StopSimulation(ctx, &pb.StopSimulationRequest{})
+
+	// Run with -race: the controls must not read the engine while it ticks
+	var last int64
+	for i := 0; i < 20; i++ {
+		if _, err := s.SetTickRate(ctx, &pb.SetTickRateRequest{TicksPerSecond: 1000}); err != nil {
+			t.Fatalf("SetTickRate failed: %v", err)
+		}
+		resp, err := s.PauseSimulation(ctx, &pb.MatchRequest{})
+		if err != nil || resp.Tick < last {
+			t.Fatalf("Expected the tick to move forward from %d, got %v (%v)", last, resp, err)
+		}
+		last = resp.Tick
+		if _, err := s.ResumeSimulation(ctx, &pb.MatchRequest{}); err != nil {
+			t.Fatalf("Resume failed: %v", err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	if last == 0 {
+		t.Error("Expected the match to tick between controls")
+	}
+}
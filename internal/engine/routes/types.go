@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	"github.com/codearena-platform/codearena-core/internal/persistence"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
 )
 
@@ -12,18 +13,31 @@ type SimulationServer struct {
 	pb.UnimplementedBotServiceServer
 	pb.UnimplementedSimulationServiceServer
 	pb.UnimplementedMatchServiceServer
-	engine            *services.SimulationEngine
+	matches           *services.MatchRegistry
+	db                *persistence.Database
+	defaultArena      *pb.ArenaConfig
 	mu                sync.Mutex
-	botChannels       map[string]chan *pb.WorldState
-	dashboardChannels map[chan *pb.WorldState]bool
+	botChannels       map[string]map[string]chan *pb.WorldState // matchID -> botID -> channel
+	dashboardChannels map[string]map[chan *pb.WorldState]bool   // matchID -> channels
 	TickRate          time.Duration
 }
 
+// NewSimulationServer creates a server hosting e as the default match.
+// Further matches share its database and arena dimensions unless configured otherwise.
 func NewSimulationServer(e *services.SimulationEngine, tickRate time.Duration) *SimulationServer {
-	return &SimulationServer{
-		engine:            e,
-		botChannels:       make(map[string]chan *pb.WorldState),
-		dashboardChannels: make(map[chan *pb.WorldState]bool),
+	s := &SimulationServer{
+		matches:           services.NewMatchRegistry(),
+		db:                e.DB,
+		defaultArena:      e.ArenaConfig,
+		botChannels:       make(map[string]map[string]chan *pb.WorldState),
+		dashboardChannels: make(map[string]map[chan *pb.WorldState]bool),
 		TickRate:          tickRate,
 	}
+
+	id := e.MatchID
+	if id == "" {
+		id = services.DefaultMatchID
+	}
+	s.matches.Add(id, e)
+	return s
 }
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// LoadArenaFile reads an arena from a YAML or JSON file whose fields use the
+// ArenaConfig names, e.g. spawn_points or zone_schedule.
+func LoadArenaFile(path string) (*pb.ArenaConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML is a superset of JSON, so both go through the YAML decoder
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	cfg := &pb.ArenaConfig{}
+	if err := protojson.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
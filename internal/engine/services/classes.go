@@ -0,0 +1,223 @@
+package services
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"go.yaml.in/yaml/v3"
+)
+
+// DefaultClassSet is the class set used when an arena does not name one.
+const DefaultClassSet = "default"
+
+//go:embed classes.yaml
+var defaultClassesYAML []byte
+
+// DefaultClasses holds the built-in class sets plus any loaded at startup.
+var DefaultClasses = mustLoadDefaultClasses()
+
+// ClassDef holds the tunable stats of a bot class.
+type ClassDef struct {
+	MaxVelocity float32 `yaml:"max_velocity" json:"max_velocity"`
+	Accel       float32 `yaml:"accel" json:"accel"`
+	Decel       float32 `yaml:"decel" json:"decel"`
+	MaxShield   float32 `yaml:"max_shield" json:"max_shield"`
+	MaxEnergy   float32 `yaml:"max_energy" json:"max_energy"`
+	EnergyRegen float32 `yaml:"energy_regen" json:"energy_regen"`
+	GunCooling  float32 `yaml:"gun_cooling" json:"gun_cooling"`
+	RadarFOV    float32 `yaml:"radar_fov" json:"radar_fov"`
+	MaxHull     float32 `yaml:"max_hull" json:"max_hull"`
+	Radius      float32 `yaml:"radius" json:"radius"`
+	// Turn limits in degrees per tick; 0 leaves turning unlimited
+	MaxTurnRate      float32 `yaml:"max_turn_rate" json:"max_turn_rate"`
+	TurnRatePerSpeed float32 `yaml:"turn_rate_per_speed" json:"turn_rate_per_speed"` // Lost per unit of velocity
+	MaxGunTurnRate   float32 `yaml:"max_gun_turn_rate" json:"max_gun_turn_rate"`
+	MaxRadarTurnRate float32 `yaml:"max_radar_turn_rate" json:"max_radar_turn_rate"`
+	// Weapons lists the projectile kinds the class can fire; shells only when empty
+	Weapons []string `yaml:"weapons" json:"weapons"`
+}
+
+func (c *ClassDef) validate() error {
+	positive := []struct {
+		name  string
+		value float32
+	}{
+		{"max_velocity", c.MaxVelocity},
+		{"accel", c.Accel},
+		{"decel", c.Decel},
+		{"max_energy", c.MaxEnergy},
+		{"gun_cooling", c.GunCooling},
+		{"radar_fov", c.RadarFOV},
+		{"max_hull", c.MaxHull},
+		{"radius", c.Radius},
+	}
+	for _, stat := range positive {
+		if stat.value <= 0 {
+			return fmt.Errorf("%s must be positive", stat.name)
+		}
+	}
+	if c.MaxShield < 0 || c.EnergyRegen < 0 {
+		return fmt.Errorf("max_shield and energy_regen must not be negative")
+	}
+	if c.MaxTurnRate < 0 || c.TurnRatePerSpeed < 0 || c.MaxGunTurnRate < 0 || c.MaxRadarTurnRate < 0 {
+		return fmt.Errorf("turn rates must not be negative")
+	}
+	if c.RadarFOV > 360 {
+		return fmt.Errorf("radar_fov must be at most 360")
+	}
+	if c.Radius*2 > MinArenaSize {
+		return fmt.Errorf("radius must be at most %g", float32(MinArenaSize/2))
+	}
+	for _, name := range c.Weapons {
+		if _, err := ParseWeapon(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TurnLimit returns how far a robot of the class can turn in one tick at the
+// given velocity, or 0 when turning is unlimited. Faster robots turn slower,
+// down to MinTurnRate.
+func (c *ClassDef) TurnLimit(velocity float32) float32 {
+	if c.MaxTurnRate <= 0 {
+		return 0
+	}
+	limit := c.MaxTurnRate - c.TurnRatePerSpeed*float32(math.Abs(float64(velocity)))
+	return float32(math.Max(math.Min(MinTurnRate, float64(c.MaxTurnRate)), float64(limit)))
+}
+
+// WeaponTypes returns the projectile kinds the class can fire.
+func (c *ClassDef) WeaponTypes() []pb.WeaponType {
+	if len(c.Weapons) == 0 {
+		return []pb.WeaponType{pb.WeaponType_SHELL}
+	}
+	types := make([]pb.WeaponType, 0, len(c.Weapons))
+	for _, name := range c.Weapons {
+		w, _ := ParseWeapon(name)
+		types = append(types, w)
+	}
+	return types
+}
+
+// CanFire reports whether the class carries a projectile kind.
+func (c *ClassDef) CanFire(weapon pb.WeaponType) bool {
+	for _, w := range c.WeaponTypes() {
+		if w == weapon {
+			return true
+		}
+	}
+	return false
+}
+
+// ClassSet maps class names to their stats. Every set defines ClassTank, which
+// unknown classes fall back to.
+type ClassSet map[string]*ClassDef
+
+// Get returns the stats of a class.
+func (s ClassSet) Get(class string) *ClassDef {
+	if def, ok := s[class]; ok {
+		return def
+	}
+	return s[ClassTank]
+}
+
+// MaxRadius returns the largest robot radius in the set.
+func (s ClassSet) MaxRadius() float32 {
+	var r float32
+	for _, def := range s {
+		r = float32(math.Max(float64(r), float64(def.Radius)))
+	}
+	return r
+}
+
+// ClassRegistry holds named class sets that arenas can reference.
+type ClassRegistry struct {
+	mu   sync.RWMutex
+	sets map[string]ClassSet
+}
+
+func NewClassRegistry() *ClassRegistry {
+	return &ClassRegistry{sets: make(map[string]ClassSet)}
+}
+
+func mustLoadDefaultClasses() *ClassRegistry {
+	r := NewClassRegistry()
+	if err := r.Load(defaultClassesYAML); err != nil {
+		panic(fmt.Sprintf("invalid built-in classes: %v", err))
+	}
+	return r
+}
+
+// Load adds the class sets in a YAML or JSON document, replacing sets of the
+// same name. Nothing is added if any set is invalid.
+func (r *ClassRegistry) Load(data []byte) error {
+	var sets map[string]ClassSet
+	if err := yaml.Unmarshal(data, &sets); err != nil {
+		return fmt.Errorf("failed to parse class sets: %w", err)
+	}
+
+	names := make([]string, 0, len(sets))
+	for name := range sets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		set := sets[name]
+		if _, ok := set[ClassTank]; !ok {
+			return fmt.Errorf("class set %q must define %s", name, ClassTank)
+		}
+		classes := make([]string, 0, len(set))
+		for class := range set {
+			classes = append(classes, class)
+		}
+		sort.Strings(classes)
+		for _, class := range classes {
+			if set[class] == nil {
+				return fmt.Errorf("class %s/%s has no stats", name, class)
+			}
+			if err := set[class].validate(); err != nil {
+				return fmt.Errorf("class %s/%s: %w", name, class, err)
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, set := range sets {
+		r.sets[name] = set
+	}
+	return nil
+}
+
+// LoadFile adds the class sets defined in a YAML or JSON file.
+func (r *ClassRegistry) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read class file: %w", err)
+	}
+	return r.Load(data)
+}
+
+// Remove drops a class set by name.
+func (r *ClassRegistry) Remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sets, name)
+}
+
+// Set returns a class set by name; an empty name selects DefaultClassSet.
+func (r *ClassRegistry) Set(name string) (ClassSet, bool) {
+	if name == "" {
+		name = DefaultClassSet
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	set, ok := r.sets[name]
+	return set, ok
+}
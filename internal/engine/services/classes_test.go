@@ -0,0 +1,93 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestDefaultClasses(t *testing.T) {
+	set, ok := DefaultClasses.Set("")
+	if !ok {
+		t.Fatal("Expected the default class set")
+	}
+	for _, class := range []string{ClassTank, ClassScout, ClassSniper} {
+		if _, ok := set[class]; !ok {
+			t.Errorf("Default set is missing %s", class)
+		}
+	}
+	if set.Get("Mech") != set[ClassTank] {
+		t.Error("Unknown classes should fall back to Tank")
+	}
+}
+
+func TestClassRegistry_Load(t *testing.T) {
+	const tank = `{"max_velocity": 6, "accel": 0.5, "decel": 1, "max_energy": 150, "gun_cooling": 0.1, "radar_fov": 60, "max_hull": 100, "radius": 20}`
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "JSON set", data: `{"arcade": {"Tank": ` + tank + `}}`},
+		{name: "YAML set", data: "arcade:\n  Tank: " + tank + "\n"},
+		{name: "Missing Tank", data: `{"arcade": {"Scout": ` + tank + `}}`, wantErr: true},
+		{name: "Zero radius", data: `{"arcade": {"Tank": {"max_velocity": 6, "accel": 1, "decel": 1, "max_energy": 1, "gun_cooling": 1, "radar_fov": 60, "max_hull": 100}}}`, wantErr: true},
+		{name: "Oversized robot", data: `{"arcade": {"Tank": {"max_velocity": 6, "accel": 1, "decel": 1, "max_energy": 1, "gun_cooling": 1, "radar_fov": 60, "max_hull": 100, "radius": 500}}}`, wantErr: true},
+		{name: "Unknown weapon", data: `{"arcade": {"Tank": {"max_velocity": 6, "accel": 1, "decel": 1, "max_energy": 1, "gun_cooling": 1, "radar_fov": 60, "max_hull": 100, "radius": 20, "weapons": ["RAILGUN"]}}}`, wantErr: true},
+		{name: "Garbage", data: `[1, 2`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewClassRegistry()
+			err := r.Load([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, ok := r.Set("arcade"); ok == tt.wantErr {
+				t.Errorf("Set registered = %v, want %v", ok, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEngine_UsesArenaClassSet(t *testing.T) {
+	err := DefaultClasses.Load([]byte(`
+test-slow:
+  Tank: {max_velocity: 1, accel: 1, decel: 1, max_energy: 10, energy_regen: 0, gun_cooling: 1, radar_fov: 10, max_hull: 40, radius: 10}
+`))
+	if err != nil {
+		t.Fatalf("Failed to load class set: %v", err)
+	}
+	t.Cleanup(func() { DefaultClasses.Remove("test-slow") })
+
+	e := NewSimulationEngine(800, 600, nil)
+	if err := e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, ClassSet: "test-slow"}); err != nil {
+		t.Fatalf("SetArenaConfig() error = %v", err)
+	}
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Class: ClassTank, Position: &pb.Vector3{X: 400, Y: 300}, Hull: 40, Energy: 10})
+
+	for i := 0; i < 10; i++ {
+		e.SetBotIntent("bot1", &pb.BotIntent{MoveDistance: 100})
+		e.Tick()
+	}
+
+	bot := e.Bots["bot1"]
+	if bot.Velocity != 1 {
+		t.Errorf("Expected velocity capped at 1 by the class set, got %f", bot.Velocity)
+	}
+	if def, ok := e.Class(ClassTank); !ok || def.MaxHull != 40 {
+		t.Errorf("Expected the match to use the test-slow Tank, got %+v", def)
+	}
+}
+
+func TestEngine_RejectsUnknownClassSet(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	if err := e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, ClassSet: "no-such-set"}); err == nil {
+		t.Fatal("Expected an error for an unknown class set")
+	}
+	if e.ArenaConfig.GetClassSet() != "" {
+		t.Errorf("Expected the arena config unchanged, got class set %q", e.ArenaConfig.GetClassSet())
+	}
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"math"
+	"sort"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// bulletImpact is the first thing a bullet's path meets during a tick.
+type bulletImpact struct {
+	t        float32 // Fraction of the tick's movement, 2 when nothing was hit
+	robot    *pb.BotState
+	obstacle *pb.ObstacleState
+	wall     bool
+}
+
+// sweptCircleHit returns the fraction of the tick at which a bullet moving from
+// (x0, y0) to (x1, y1) touches a robot moving from p0 to p1, or -1 if it does
+// not. Both move linearly, so the test runs in the robot's frame of reference.
+func sweptCircleHit(x0, y0, x1, y1 float32, p0, p1 *pb.Vector3, radius float32) float32 {
+	return segmentCircleIntersection(x0-p0.X, y0-p0.Y, x1-p1.X, y1-p1.Y, 0, 0, radius)
+}
+
+// segmentExitFraction returns the fraction along a segment where it leaves the
+// arena, or -1 if it ends inside.
+func segmentExitFraction(x0, y0, x1, y1, width, height float32) float32 {
+	if x1 >= 0 && x1 <= width && y1 >= 0 && y1 <= height {
+		return -1
+	}
+	t := float32(1)
+	exit := func(from, to, bound float32) {
+		if f := (bound - from) / (to - from); f >= 0 && f < t {
+			t = f
+		}
+	}
+	if x1 < 0 {
+		exit(x0, x1, 0)
+	} else if x1 > width {
+		exit(x0, x1, width)
+	}
+	if y1 < 0 {
+		exit(y0, y1, 0)
+	} else if y1 > height {
+		exit(y0, y1, height)
+	}
+	return t
+}
+
+// sweepBullet finds what a bullet hits first on its way to (x1, y1): a robot,
+// an obstacle or the arena wall. Robots are swept from their previous to their
+// updated position; reach widens the quadtree query by how far robots can be
+// from their previous position.
+func (pe *PhysicsEngine) sweepBullet(bullet *pb.BulletState, x1, y1, reach float32, qt *Quadtree, robots map[string]*pb.BotState, obstacles []*pb.ObstacleState, arena *pb.ArenaConfig) bulletImpact {
+	x0, y0 := bullet.Position.X, bullet.Position.Y
+	impact := bulletImpact{t: 2}
+	// Proximity weapons go off when a robot comes within their trigger radius
+	trigger := weaponOf(bullet).TriggerRadius
+
+	if t := segmentExitFraction(x0, y0, x1, y1, arena.Width, arena.Height); t >= 0 {
+		impact = bulletImpact{t: t, wall: true}
+	}
+	if o, t := firstObstacleHitAt(x0, y0, x1, y1, obstacles); o != nil && t < impact.t {
+		impact = bulletImpact{t: t, obstacle: o}
+	}
+
+	query := Rectangle{
+		X: (x0 + x1) / 2,
+		Y: (y0 + y1) / 2,
+		W: float32(math.Abs(float64(x1-x0)))/2 + reach + trigger,
+		H: float32(math.Abs(float64(y1-y0)))/2 + reach + trigger,
+	}
+	var nearbyBots []*pb.BotState
+	qt.Query(query, &nearbyBots)
+
+	for _, old := range nearbyBots {
+		if old.Id == bullet.OwnerId {
+			continue
+		}
+		// Without friendly fire, bullets pass through teammates
+		if !arena.FriendlyFire && sameTeam(bullet.TeamId, old.TeamId) {
+			continue
+		}
+		robot, alive := robots[old.Id]
+		if !alive {
+			// Robot is already dead this tick
+			continue
+		}
+		t := sweptCircleHit(x0, y0, x1, y1, old.Position, robot.Position, pe.Classes.Get(robot.Class).Radius+trigger)
+		if t >= 0 && t < impact.t {
+			impact = bulletImpact{t: t, robot: robot}
+		}
+	}
+	return impact
+}
+
+// collideBullets finds bullets that meet in flight before reaching anything
+// else. Earlier meetings win, so each bullet is destroyed at most once. It
+// returns the indexes of destroyed bullets.
+func collideBullets(bullets []*pb.BulletState, ends [][2]float32, impacts []bulletImpact, newState *pb.WorldState) map[int]bool {
+	type meeting struct {
+		i, j int
+		t    float32
+	}
+	var meetings []meeting
+	for i, a := range bullets {
+		for j := i + 1; j < len(bullets); j++ {
+			b := bullets[j]
+			if a.OwnerId == b.OwnerId {
+				continue
+			}
+			// Relative motion of a against b, touching within two bullet radii
+			t := segmentCircleIntersection(
+				a.Position.X-b.Position.X, a.Position.Y-b.Position.Y,
+				ends[i][0]-ends[j][0], ends[i][1]-ends[j][1],
+				0, 0, BulletRadius*2)
+			if t >= 0 && t <= impacts[i].t && t <= impacts[j].t {
+				meetings = append(meetings, meeting{i: i, j: j, t: t})
+			}
+		}
+	}
+	sort.SliceStable(meetings, func(x, y int) bool { return meetings[x].t < meetings[y].t })
+
+	destroyed := make(map[int]bool)
+	for _, m := range meetings {
+		if destroyed[m.i] || destroyed[m.j] {
+			continue
+		}
+		destroyed[m.i], destroyed[m.j] = true, true
+		a, b := bullets[m.i], bullets[m.j]
+		newState.Events = append(newState.Events, &pb.SimulationEvent{
+			Tick: newState.Tick,
+			Event: &pb.SimulationEvent_BulletHitBullet{
+				BulletHitBullet: &pb.BulletHitBulletEvent{
+					BulletId:      a.Id,
+					OwnerId:       a.OwnerId,
+					OtherBulletId: b.Id,
+					OtherOwnerId:  b.OwnerId,
+				},
+			},
+		})
+	}
+	return destroyed
+}
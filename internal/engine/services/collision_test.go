@@ -0,0 +1,165 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestSweptCircleHit_Tunnelling(t *testing.T) {
+	tests := []struct {
+		name           string
+		x0, y0, x1, y1 float32
+		p0, p1         *pb.Vector3
+		wantHit        bool
+	}{
+		{
+			name: "Fast bullet through a still robot",
+			x0:   0, y0: 0, x1: 100, y1: 0,
+			p0: &pb.Vector3{X: 50}, p1: &pb.Vector3{X: 50},
+			wantHit: true,
+		},
+		{
+			name: "Robot crosses the bullet path mid-tick",
+			x0:   0, y0: 0, x1: 100, y1: 0,
+			p0: &pb.Vector3{X: 50, Y: -60}, p1: &pb.Vector3{X: 50, Y: 60},
+			wantHit: true,
+		},
+		{
+			name: "Robot left the bullet's end point before it arrived",
+			x0:   0, y0: 0, x1: 20, y1: 0,
+			p0: &pb.Vector3{X: 25}, p1: &pb.Vector3{X: 25, Y: 45},
+			wantHit: false,
+		},
+		{
+			name: "Robot runs into a slow bullet",
+			x0:   0, y0: 0, x1: 5, y1: 0,
+			p0: &pb.Vector3{X: 60}, p1: &pb.Vector3{X: 20},
+			wantHit: true,
+		},
+		{
+			name: "Parallel paths never touch",
+			x0:   0, y0: 0, x1: 100, y1: 0,
+			p0: &pb.Vector3{X: 0, Y: 30}, p1: &pb.Vector3{X: 100, Y: 30},
+			wantHit: false,
+		},
+		{
+			name: "Bullet stops short of the robot",
+			x0:   0, y0: 0, x1: 20, y1: 0,
+			p0: &pb.Vector3{X: 45}, p1: &pb.Vector3{X: 45},
+			wantHit: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hit := sweptCircleHit(tt.x0, tt.y0, tt.x1, tt.y1, tt.p0, tt.p1, RobotRadius) >= 0
+			if hit != tt.wantHit {
+				t.Errorf("hit = %v, want %v", hit, tt.wantHit)
+			}
+		})
+	}
+}
+
+func TestSegmentExitFraction(t *testing.T) {
+	if f := segmentExitFraction(10, 10, 50, 50, 100, 100); f != -1 {
+		t.Errorf("Segment inside the arena should not exit, got %f", f)
+	}
+	if f := segmentExitFraction(80, 50, 120, 50, 100, 100); f != 0.5 {
+		t.Errorf("Expected to leave halfway, got %f", f)
+	}
+	if f := segmentExitFraction(50, 10, 50, -30, 100, 100); f != 0.25 {
+		t.Errorf("Expected to leave a quarter of the way, got %f", f)
+	}
+}
+
+func bulletHits(state *pb.WorldState) map[string]string {
+	hits := map[string]string{}
+	for _, ev := range state.Events {
+		if hit := ev.GetBulletHitTarget(); hit != nil {
+			hits[hit.BulletId] = hit.TargetId
+		}
+	}
+	return hits
+}
+
+func TestPhysicsUpdate_FastBulletDoesNotTunnel(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{{Id: "target", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100}},
+		Bullets: []*pb.BulletState{
+			// Starts 50 short of the target and ends 50 past it
+			{Id: "railgun", OwnerId: "shooter", Position: &pb.Vector3{X: 400, Y: 250}, Heading: 180, Velocity: 100, Power: 5},
+		},
+	}
+
+	newState := pe.Update(state, arena, nil)
+	if bulletHits(newState)["railgun"] != "target" {
+		t.Error("Fast bullet tunnelled through the target")
+	}
+	if len(newState.Bullets) != 0 {
+		t.Error("Bullet should stop at the target")
+	}
+}
+
+func TestPhysicsUpdate_NearestTargetIsHit(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "a_far", Position: &pb.Vector3{X: 400, Y: 380}, Hull: 100},
+			{Id: "b_near", Position: &pb.Vector3{X: 400, Y: 320}, Hull: 100},
+		},
+		Bullets: []*pb.BulletState{
+			{Id: "b1", OwnerId: "shooter", Position: &pb.Vector3{X: 400, Y: 280}, Heading: 180, Velocity: 120, Power: 5},
+		},
+	}
+
+	if got := bulletHits(pe.Update(state, arena, nil))["b1"]; got != "b_near" {
+		t.Errorf("Expected the nearer robot to take the hit, got %q", got)
+	}
+}
+
+func TestPhysicsUpdate_ObstacleShieldsRobotBehindIt(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{
+		Bots:      []*pb.BotState{{Id: "target", Position: &pb.Vector3{X: 400, Y: 360}, Hull: 100}},
+		Obstacles: []*pb.ObstacleState{{Id: "wall", Position: &pb.Vector3{X: 400, Y: 310}, Radius: 10, Indestructible: true}},
+		Bullets: []*pb.BulletState{
+			{Id: "b1", OwnerId: "shooter", Position: &pb.Vector3{X: 400, Y: 280}, Heading: 180, Velocity: 100, Power: 5},
+		},
+	}
+
+	if hits := bulletHits(pe.Update(state, arena, nil)); len(hits) != 0 {
+		t.Errorf("Obstacle should stop the bullet first, got hits %v", hits)
+	}
+}
+
+func TestPhysicsUpdate_BulletCollisions(t *testing.T) {
+	state := &pb.WorldState{
+		Bullets: []*pb.BulletState{
+			{Id: "east", OwnerId: "a", Position: &pb.Vector3{X: 390, Y: 300}, Heading: 90, Velocity: 20, Power: 1},
+			{Id: "west", OwnerId: "b", Position: &pb.Vector3{X: 410, Y: 300}, Heading: 270, Velocity: 20, Power: 1},
+		},
+	}
+
+	for _, enabled := range []bool{false, true} {
+		arena := &pb.ArenaConfig{Width: 800, Height: 600, BulletCollisions: enabled}
+		newState := NewPhysicsEngine().Update(state, arena, nil)
+
+		collided := false
+		for _, ev := range newState.Events {
+			if hit := ev.GetBulletHitBullet(); hit != nil && hit.BulletId == "east" && hit.OtherBulletId == "west" {
+				collided = true
+			}
+		}
+		if collided != enabled {
+			t.Errorf("bullet_collisions=%v: collided = %v", enabled, collided)
+		}
+		if wantBullets := map[bool]int{false: 2, true: 0}[enabled]; len(newState.Bullets) != wantBullets {
+			t.Errorf("bullet_collisions=%v: expected %d bullets left, got %d", enabled, wantBullets, len(newState.Bullets))
+		}
+	}
+}
@@ -1,40 +1,52 @@
 package services
 
-// Physics Constants
+// Built-in bot classes; their stats live in classes.yaml
 const (
-	// Tank Class Constants
-	TankMaxVelocity = 6.0
-	TankAccel       = 0.5
-	TankDecel       = 1.0
-	TankGunCooling  = 0.1
-	TankMaxShield   = 50.0
-	TankMaxEnergy   = 150.0
-	TankRadarFOV    = 60.0
-
-	// Scout Class Constants
-	ScoutMaxVelocity = 12.0
-	ScoutAccel       = 2.0
-	ScoutDecel       = 3.0
-	ScoutGunCooling  = 0.2
-	ScoutMaxShield   = 20.0
-	ScoutMaxEnergy   = 80.0
-	ScoutRadarFOV    = 120.0
-
-	// Sniper Class Constants
-	SniperMaxVelocity = 8.0
-	SniperAccel       = 1.0
-	SniperDecel       = 2.0
-	SniperGunCooling  = 0.05
-	SniperMaxShield   = 30.0
-	SniperMaxEnergy   = 100.0
-	SniperRadarFOV    = 30.0
+	ClassTank   = "Tank"
+	ClassScout  = "Scout"
+	ClassSniper = "Sniper"
+)
 
+// Physics Constants
+const (
 	// Global Constants
-	RadarRange  = 800.0
-	RobotRadius = 20.0 // Effective radius for collision/hit detection
+	RadarRange   = 800.0
+	RobotRadius  = 20.0 // Reference robot radius that arena limits are sized for
+	BulletRadius = 3.0  // Only used when bullets can collide with each other
+
+	// Intent Limits
+	MinFirePower = 0.1
+	MaxFirePower = 3.0
+	MinTurnRate  = 2.0 // Body turn rate at any speed, in degrees per tick
+
+	// Zone Effects (per tick at intensity 1)
+	HealZoneAmount       = 0.1
+	EnergyZoneAmount     = 0.5
+	HazardZoneDamage     = 0.2
+	SpeedBoostZoneFactor = 1.5
+	SlowZoneFactor       = 0.5
+	ZoneDamageSource     = "zone" // Killer ID for zone deaths; hazard zones append ":<zone id>"
+
+	// Team Play
+	TeamMessageMaxLength = 256 // Bytes relayed per team message
+
+	// Obstacles
+	ObstacleDefaultHP     = 100.0 // Starting hit points of destructible obstacles
+	ObstacleResolvePasses = 16    // Push-out passes before a wedged robot is left where it is
+
+	// Pickups (default amounts per pickup)
+	PickupRadius       = 10.0 // Collected by robots that touch this circle
+	HealthPickupAmount = 30.0
+	EnergyPickupAmount = 50.0
+	AmmoPickupAmount   = 10.0 // Gun heat removed
+
+	// Spawning (generated spawns only; configured spawn points are used as given)
+	SpawnClearance   = 40.0  // Gap kept between a new robot and walls or obstacles
+	SpawnBotDistance = 150.0 // Distance kept between robot centers
+	SpawnAttempts    = 100   // Random positions tried before settling for the roomiest
 
-	// Zone Effects (per tick)
-	HealZoneAmount   = 0.1
-	EnergyZoneAmount = 0.5
-	HazardZoneDamage = 0.2
+	// Sessions
+	DefaultReconnectGraceTicks = 600            // Ticks a disconnected robot idles before it forfeits
+	DisconnectSource           = "disconnect"   // Killer ID for robots that forfeit after disconnecting
+	DisqualifiedSource         = "disqualified" // Killer ID for robots that skipped too many turns
 )
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// BotController drives a bot from inside the engine process, without a
+// network connection. Intent is called once per tick with the bot's view of
+// the previous tick, its own state first, and returns its intent for the next
+// one, or nil to idle.
+type BotController interface {
+	Intent(view *pb.WorldState) *pb.BotIntent
+}
+
+// ControllerFactory creates the controller for one bot.
+type ControllerFactory func() BotController
+
+var (
+	controllersMu sync.RWMutex
+	controllers   = map[string]ControllerFactory{}
+)
+
+// RegisterController makes an in-process bot available by name.
+func RegisterController(name string, factory ControllerFactory) {
+	controllersMu.Lock()
+	defer controllersMu.Unlock()
+	controllers[name] = factory
+}
+
+// UnregisterController removes an in-process bot.
+func UnregisterController(name string) {
+	controllersMu.Lock()
+	defer controllersMu.Unlock()
+	delete(controllers, name)
+}
+
+// LookupController returns the factory of an in-process bot.
+func LookupController(name string) (ControllerFactory, bool) {
+	controllersMu.RLock()
+	defer controllersMu.RUnlock()
+	factory, ok := controllers[name]
+	return factory, ok
+}
+
+// ControllerNames lists the registered in-process bots in name order.
+func ControllerNames() []string {
+	controllersMu.RLock()
+	defer controllersMu.RUnlock()
+	names := make([]string, 0, len(controllers))
+	for name := range controllers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RecordedController replays the intents a bot sent in a recorded match.
+type RecordedController struct {
+	intents map[int64]*pb.BotIntent // By the tick they were applied in
+}
+
+// NewRecordedController extracts the intents of one bot from the most recent
+// recorded run of a match.
+func NewRecordedController(records []*pb.TickRecord, botID string) (*RecordedController, error) {
+	records, err := latestRun(records)
+	if err != nil {
+		return nil, err
+	}
+	c := &RecordedController{intents: make(map[int64]*pb.BotIntent)}
+	for _, r := range records {
+		for _, in := range r.Intents {
+			if in.BotId == botID {
+				c.intents[r.Tick] = in.Intent
+			}
+		}
+	}
+	if len(c.intents) == 0 {
+		return nil, fmt.Errorf("no recorded intents for bot %s", botID)
+	}
+	return c, nil
+}
+
+func (c *RecordedController) Intent(view *pb.WorldState) *pb.BotIntent {
+	return c.intents[view.Tick+1]
+}
+
+// Attach drives a joined bot with ctrl from the next tick on.
+func (e *SimulationEngine) Attach(botID string, ctrl BotController) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.controllers == nil {
+		e.controllers = make(map[string]BotController)
+	}
+	e.controllers[botID] = ctrl
+	delete(e.greeted, botID)
+}
+
+// JoinBuiltinBots joins the in-process bots the arena config declares, as
+// builtin_1, builtin_2, ..., and attaches their controllers.
+func (e *SimulationEngine) JoinBuiltinBots() error {
+	e.mu.RLock()
+	builtins := e.ArenaConfig.GetBuiltinBots()
+	e.mu.RUnlock()
+
+	for i, b := range builtins {
+		factory, ok := LookupController(b.Ai)
+		if !ok {
+			return fmt.Errorf("builtin bot %d: unknown AI %q", i, b.Ai)
+		}
+		name := b.Name
+		if name == "" {
+			name = b.Ai
+		}
+		id := fmt.Sprintf("builtin_%d", i+1)
+		if _, err := e.Join(id, name, b.Class, b.TeamId); err != nil {
+			return fmt.Errorf("builtin bot %d: %w", i, err)
+		}
+		e.Attach(id, factory())
+	}
+	return nil
+}
+
+// driveControllers asks every attached controller of a live bot for its
+// intent, in bot ID order so runs can be reproduced. A controller's first
+// view carries the join handshake, like the first state a connected bot gets.
+func (e *SimulationEngine) driveControllers() {
+	e.mu.Lock()
+	ids := make([]string, 0, len(e.controllers))
+	for id := range e.controllers {
+		if _, alive := e.Bots[id]; alive {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	ctrls := make([]BotController, len(ids))
+	greet := make([]bool, len(ids))
+	if e.greeted == nil {
+		e.greeted = make(map[string]bool)
+	}
+	for i, id := range ids {
+		ctrls[i] = e.controllers[id]
+		greet[i] = !e.greeted[id]
+		e.greeted[id] = true
+	}
+	view, matchID, arena := e.lastState, e.MatchID, e.ArenaConfig
+	e.mu.Unlock()
+	if len(ids) == 0 {
+		return
+	}
+
+	if view == nil {
+		view = e.GetWorldState()
+	}
+	for i, id := range ids {
+		botView := e.Physics.FilterStateForBot(id, view)
+		if greet[i] {
+			botView.JoinAccepted = &pb.JoinAccepted{BotId: id, MatchId: matchID, Arena: arena}
+		}
+		if intent := ctrls[i].Intent(botView); intent != nil {
+			e.SetBotIntent(id, intent)
+		}
+	}
+}
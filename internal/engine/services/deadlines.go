@@ -0,0 +1,128 @@
+package services
+
+import (
+	"log/slog"
+	"sort"
+	"time"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// What a bot gets on a turn it has no intent in time for
+const (
+	OnMissSkip  = "SKIP"
+	OnMissReuse = "REUSE"
+
+	// stateTimeSlots is how many recent states intent latency can be measured against
+	stateTimeSlots = 64
+)
+
+// stateTime is when the state of a tick was produced.
+type stateTime struct {
+	tick int64
+	at   time.Time
+}
+
+// noteStateTime remembers when the state of the current tick was produced.
+// The caller must hold e.mu.
+func (e *SimulationEngine) noteStateTime() {
+	e.stateTimes[e.CurrentTick%stateTimeSlots] = stateTime{tick: e.CurrentTick, at: time.Now()}
+}
+
+// measureLatency credits the time since the state an intent answers to the
+// bot's stats. The caller must hold e.mu.
+func (e *SimulationEngine) measureLatency(botID string, intent *pb.BotIntent) {
+	st, ok := e.Stats[botID]
+	if !ok || intent.GetTick() <= 0 {
+		return
+	}
+	if sent := e.stateTimes[intent.Tick%stateTimeSlots]; sent.tick == intent.Tick && !sent.at.IsZero() {
+		st.Latency += time.Since(sent.at)
+		st.Responses++
+	}
+}
+
+// applyDeadlines picks the intent each networked bot plays this tick. Intents
+// answering a state older than the arena's deadline are stale; a bot without
+// an intent in time skips its turn or repeats its last intent, and is
+// disqualified once it has skipped too many turns. Bots are not held to the
+// deadline on the tick they connect in, nor while disconnected, nor at all
+// when the arena sets none, and other bots' intents pass through. The caller
+// must hold e.mu.
+func (e *SimulationEngine) applyDeadlines(intents map[string]*pb.BotIntent) map[string]*pb.BotIntent {
+	applied := make(map[string]*pb.BotIntent, len(intents))
+	for id, intent := range intents {
+		applied[id] = intent
+	}
+
+	deadline := e.ArenaConfig.GetIntentDeadline()
+	latest := e.CurrentTick - 1 // The newest state bots can have seen
+	ids := make([]string, 0, len(e.remote))
+	for id := range e.remote {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var disqualified []string
+	for _, id := range ids {
+		if _, alive := e.Bots[id]; !alive {
+			delete(e.remote, id)
+			continue
+		}
+		if _, offline := e.offline[id]; offline || e.remote[id] == e.CurrentTick {
+			continue
+		}
+		st := e.Stats[id]
+
+		intent := intents[id]
+		if intent != nil && deadline != nil {
+			// Intents that do not say which state they answer, or claim one
+			// that has not been sent yet, are stale too
+			if answered := intent.Tick; answered <= 0 || answered > latest || latest-answered > deadline.Ticks {
+				st.StaleIntents++
+				intent = nil
+			}
+		}
+		if intent != nil {
+			st.OnTime++
+			if e.lastIntents == nil {
+				e.lastIntents = make(map[string]*pb.BotIntent)
+			}
+			e.lastIntents[id] = intent
+			applied[id] = intent
+			continue
+		}
+		if deadline == nil {
+			continue // Without a deadline a bot is never late
+		}
+
+		st.SkippedTurns++
+		delete(applied, id)
+		if deadline.GetOnMiss() == OnMissReuse {
+			if last := e.lastIntents[id]; last != nil {
+				reused := proto.Clone(last).(*pb.BotIntent)
+				reused.TeamMessage = ""
+				applied[id] = reused
+			}
+		}
+		if budget := deadline.GetMaxSkippedTurns(); budget > 0 && st.SkippedTurns > int(budget) {
+			disqualified = append(disqualified, id)
+		}
+	}
+
+	for _, id := range disqualified {
+		slog.Info("Bot disqualified for missing its intent deadline", "match_id", e.MatchID, "bot_id", id, "skipped_turns", e.Stats[id].SkippedTurns)
+		delete(e.Bots, id)
+		delete(e.remote, id)
+		delete(applied, id)
+		e.Stats[id].Deaths++
+		e.Events = append(e.Events, &pb.SimulationEvent{
+			Tick: e.CurrentTick,
+			Event: &pb.SimulationEvent_Death{
+				Death: &pb.DeathEvent{BotId: id, KillerId: DisqualifiedSource},
+			},
+		})
+	}
+	return applied
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// deadlineEngine runs a match where bot a plays over the network and bot b
+// in-process.
+func deadlineEngine(deadline *pb.IntentDeadline, db *persistence.Database) *SimulationEngine {
+	e := NewSimulationEngine(800, 600, db)
+	e.MatchID = "deadline-match"
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Seed: 1, IntentDeadline: deadline})
+	e.SetBot("a", &pb.BotState{Id: "a", Position: &pb.Vector3{X: 200, Y: 300}, Hull: 100, Energy: 100})
+	e.SetBot("b", &pb.BotState{Id: "b", Position: &pb.Vector3{X: 600, Y: 300}, Hull: 100, Energy: 100})
+	e.OpenSession("a")
+	e.Status = pb.MatchStatus_RUNNING
+	e.Tick()
+	return e
+}
+
+func TestDeadlines_StaleIntents(t *testing.T) {
+	tests := []struct {
+		name   string
+		tick   int64 // Answered tick, relative to the latest state
+		stale  bool
+		legacy bool
+	}{
+		{name: "Latest state", tick: 0},
+		{name: "Within the deadline", tick: -1},
+		{name: "Too old", tick: -2, stale: true},
+		{name: "From the future", tick: 1, stale: true},
+		{name: "Without a tick", legacy: true, stale: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := deadlineEngine(&pb.IntentDeadline{Ticks: 1}, nil)
+			// Give the bot a state older than the latest to answer
+			e.SetBotIntent("a", &pb.BotIntent{Tick: e.CurrentTick})
+			e.Tick()
+
+			before := *e.Stats["a"]
+			heading := e.Bots["a"].Heading
+			intent := &pb.BotIntent{TurnDegrees: 5}
+			if !tt.legacy {
+				intent.Tick = e.CurrentTick + tt.tick
+			}
+			e.SetBotIntent("a", intent)
+			e.Tick()
+
+			st := e.Stats["a"]
+			turned := e.Bots["a"].Heading != heading
+			if tt.stale {
+				if turned || st.StaleIntents != before.StaleIntents+1 || st.SkippedTurns != before.SkippedTurns+1 {
+					t.Errorf("Expected a stale, skipped turn, got turned=%v and %+v", turned, st)
+				}
+			} else if !turned || st.OnTime != before.OnTime+1 {
+				t.Errorf("Expected the intent to apply, got turned=%v and %+v", turned, st)
+			}
+			if e.Stats["b"].SkippedTurns != 0 {
+				t.Errorf("Expected in-process bots to be exempt, got %+v", e.Stats["b"])
+			}
+		})
+	}
+}
+
+func TestDeadlines_ReuseLastIntent(t *testing.T) {
+	e := deadlineEngine(&pb.IntentDeadline{Ticks: 1, OnMiss: OnMissReuse}, nil)
+
+	e.SetBotIntent("a", &pb.BotIntent{Tick: e.CurrentTick, TurnDegrees: 5, TeamMessage: "hi"})
+	e.Tick()
+	e.Tick()
+	if heading := e.Bots["a"].Heading; heading != 10 {
+		t.Errorf("Expected the turn to repeat, got heading %v", heading)
+	}
+	if st := e.Stats["a"]; st.SkippedTurns != 1 || st.OnTime != 1 {
+		t.Errorf("Expected one skipped turn, got %+v", st)
+	}
+}
+
+func TestDeadlines_Disqualify(t *testing.T) {
+	e := deadlineEngine(&pb.IntentDeadline{Ticks: 1, MaxSkippedTurns: 3}, nil)
+
+	var state *pb.WorldState
+	for i := 0; i < 3; i++ {
+		state = e.Tick()
+	}
+	if _, alive := e.Bots["a"]; !alive {
+		t.Fatal("Expected the bot to stay within its budget")
+	}
+
+	state = e.Tick()
+	if _, alive := e.Bots["a"]; alive {
+		t.Fatal("Expected the bot to be disqualified")
+	}
+	death := findEvent(state, func(ev *pb.SimulationEvent) bool { return ev.GetDeath() != nil })
+	if death == nil || death.GetDeath().KillerId != DisqualifiedSource {
+		t.Errorf("Expected a disqualification, got %v", death)
+	}
+	if e.Status != pb.MatchStatus_FINISHED {
+		t.Errorf("Expected the match to end, got %s", e.Status)
+	}
+}
+
+func TestDeadlines_NoDeadline(t *testing.T) {
+	e := deadlineEngine(nil, nil)
+
+	for i := 0; i < 5; i++ {
+		e.Tick()
+	}
+	if got := e.Stats["a"].SkippedTurns; got != 0 {
+		t.Errorf("Expected no skipped turns without a deadline, got %d", got)
+	}
+}
+
+func TestDeadlines_SteppedTicksExempt(t *testing.T) {
+	db, err := persistence.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	e := deadlineEngine(&pb.IntentDeadline{Ticks: 1, MaxSkippedTurns: 1}, db)
+	for i := 0; i < 5; i++ {
+		e.StepTick()
+	}
+	if _, alive := e.Bots["a"]; !alive {
+		t.Fatal("Expected stepped ticks not to disqualify the bot")
+	}
+	if st := e.Stats["a"]; st.SkippedTurns != 0 {
+		t.Errorf("Expected no skipped turns while stepping, got %+v", st)
+	}
+
+	// Live ticks are held to the deadline again
+	e.Tick()
+	e.Tick()
+	if _, alive := e.Bots["a"]; alive {
+		t.Error("Expected the bot to be disqualified once the match runs")
+	}
+
+	records, err := LoadTickRecords(db, "deadline-match")
+	if err != nil {
+		t.Fatalf("Failed to load records: %v", err)
+	}
+	if _, err := VerifyReplay(records); err != nil {
+		t.Errorf("Replay diverged: %v", err)
+	}
+}
+
+func TestDeadlines_Latency(t *testing.T) {
+	e := deadlineEngine(nil, nil)
+
+	const think = 20 * time.Millisecond
+	time.Sleep(think)
+	e.SetBotIntent("a", &pb.BotIntent{Tick: e.CurrentTick})
+	e.SetBotIntent("a", &pb.BotIntent{Tick: e.CurrentTick + 100}) // Not sent yet
+	st := e.Stats["a"]
+	if st.Responses != 1 {
+		t.Fatalf("Expected one measured response, got %+v", st)
+	}
+	if latency := st.MeanLatency(); latency < think || latency > time.Second {
+		t.Errorf("Expected a latency of about %v, got %v", think, latency)
+	}
+}
+
+func TestDeadlines_Replay(t *testing.T) {
+	db, err := persistence.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	e := deadlineEngine(&pb.IntentDeadline{Ticks: 0, OnMiss: OnMissReuse, MaxSkippedTurns: 5}, db)
+	for i := 0; i < 40 && e.Status != pb.MatchStatus_FINISHED; i++ {
+		// Answers every third state late
+		answered := e.CurrentTick
+		if i%3 == 0 {
+			answered--
+		}
+		e.SetBotIntent("a", &pb.BotIntent{Tick: answered, MoveDistance: 20, TurnDegrees: float32(i % 4)})
+		e.Tick()
+	}
+	if _, alive := e.Bots["a"]; alive {
+		t.Fatal("Expected the late bot to be disqualified")
+	}
+
+	records, err := LoadTickRecords(db, "deadline-match")
+	if err != nil {
+		t.Fatalf("Failed to load records: %v", err)
+	}
+	if _, err := VerifyReplay(records); err != nil {
+		t.Errorf("Replay diverged: %v", err)
+	}
+}
@@ -1,10 +1,14 @@
 package services
 
 import (
+	"fmt"
+	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/codearena-platform/codearena-core/internal/persistence"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 type SimulationEngine struct {
@@ -14,27 +18,70 @@ type SimulationEngine struct {
 	Intents     map[string]*pb.BotIntent
 	mu          sync.RWMutex
 	Bullets     []*pb.BulletState
+	Obstacles   []*pb.ObstacleState
+	Pickups     []*pb.PickupState
 	Events      []*pb.SimulationEvent
 	Zone        *pb.ZoneState
 	CurrentTick int64
 	Status      pb.MatchStatus
 	Physics     *PhysicsEngine
 	DB          *persistence.Database
+
+	// Stats tracks every bot that joined the match, alive or not
+	Stats         map[string]*BotStats
+	WinConditions []WinCondition
+	// Spawns lists where each bot entered the match, in join order
+	Spawns []SpawnAssignment
+
+	// Rand is the match RNG, seeded from ArenaConfig.Seed so runs can be reproduced
+	Rand *rand.Rand
+	// joined holds bots added since the last tick, for the tick record
+	joined []*pb.BotState
+	// lastFrame is the last state saved for replays, the base of the next delta
+	lastFrame *pb.WorldState
+	// lastState is the state of the last tick, shown to in-process bots
+	lastState *pb.WorldState
+	// controllers drive the bots that run inside the engine process
+	controllers map[string]BotController
+	// greeted holds the controllers already shown the join handshake
+	greeted map[string]bool
+	// sessions maps session tokens to the bots they resume
+	sessions map[string]string
+	// connections holds connection changes since the last tick
+	connections []*pb.ConnectionChange
+	// offline maps disconnected bots to the tick they dropped in
+	offline map[string]int64
+	// remote maps the bots that play over the network to the tick they last
+	// connected in
+	remote map[string]int64
+	// lastIntents holds the intent each networked bot last had applied
+	lastIntents map[string]*pb.BotIntent
+	// stateTimes holds when recent states were produced, by tick
+	stateTimes [stateTimeSlots]stateTime
+	// stepped marks the next tick as stepped while paused
+	stepped bool
+	// startedAt is when the game loop first started the match
+	startedAt time.Time
 }
 
 func NewSimulationEngine(width, height float32, db *persistence.Database) *SimulationEngine {
+	arena := &pb.ArenaConfig{
+		Width:  width,
+		Height: height,
+		Seed:   newSeed(),
+	}
 	return &SimulationEngine{
-		ArenaConfig: &pb.ArenaConfig{
-			Width:  width,
-			Height: height,
-		},
-		Bots:    make(map[string]*pb.BotState),
-		Intents: make(map[string]*pb.BotIntent),
-		Bullets: make([]*pb.BulletState, 0),
-		Events:  make([]*pb.SimulationEvent, 0),
-		Status:  pb.MatchStatus_WAITING,
-		Physics: NewPhysicsEngine(),
-		DB:      db,
+		ArenaConfig:   arena,
+		Bots:          make(map[string]*pb.BotState),
+		Intents:       make(map[string]*pb.BotIntent),
+		Bullets:       make([]*pb.BulletState, 0),
+		Events:        make([]*pb.SimulationEvent, 0),
+		Status:        pb.MatchStatus_WAITING,
+		Physics:       NewPhysicsEngine(),
+		DB:            db,
+		Stats:         make(map[string]*BotStats),
+		WinConditions: NewWinConditions(arena),
+		Rand:          rand.New(rand.NewSource(arena.Seed)),
 	}
 }
 
@@ -42,10 +89,108 @@ func (e *SimulationEngine) SetBotIntent(id string, intent *pb.BotIntent) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.Intents[id] = intent
+	e.measureLatency(id, intent)
 }
 
 func (e *SimulationEngine) SetBot(id string, state *pb.BotState) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	e.Bots[id] = state
+	e.joined = append(e.joined, proto.Clone(state).(*pb.BotState))
+	if _, ok := e.Stats[id]; !ok {
+		e.Stats[id] = &BotStats{BotID: id, Name: state.Name, TeamID: state.TeamId}
+	}
+}
+
+// Join adds a bot of the given class, a Tank when empty, at its assigned
+// spawn with full hull and energy. The name defaults to the ID.
+func (e *SimulationEngine) Join(id, name, class, team string) (*pb.BotState, error) {
+	if class == "" {
+		class = ClassTank
+	}
+	def, ok := e.Class(class)
+	if !ok {
+		return nil, fmt.Errorf("unknown bot class %q", class)
+	}
+	if name == "" {
+		name = id
+	}
+
+	bot := &pb.BotState{
+		Id:      id,
+		Name:    name,
+		Class:   class,
+		TeamId:  team,
+		Hull:    def.MaxHull,
+		Energy:  def.MaxEnergy,
+		Weapons: def.WeaponTypes(),
+	}
+	e.AssignSpawn(bot)
+	e.SetBot(id, bot)
+	return bot, nil
+}
+
+// HasJoined reports whether a bot with the given ID ever joined the match.
+func (e *SimulationEngine) HasJoined(id string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.Stats[id]
+	return ok
+}
+
+// JoinedCount returns how many bots have joined the match, including those
+// that died or are disconnected.
+func (e *SimulationEngine) JoinedCount() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return len(e.Stats)
+}
+
+// GetStatus returns the match status.
+func (e *SimulationEngine) GetStatus() pb.MatchStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.Status
+}
+
+func (e *SimulationEngine) setStatus(status pb.MatchStatus) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.Status = status
+}
+
+// SetArenaConfig replaces the arena layout and rules, resetting the obstacles,
+// pickups, safe zone, win conditions, bot classes and RNG it declares. A zero seed is
+// replaced by a fresh one. An unknown class set leaves the engine unchanged.
+func (e *SimulationEngine) SetArenaConfig(cfg *pb.ArenaConfig) error {
+	classes, ok := DefaultClasses.Set(cfg.ClassSet)
+	if !ok {
+		return fmt.Errorf("unknown class_set %q", cfg.ClassSet)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if cfg.Seed == 0 {
+		cfg.Seed = newSeed()
+	}
+	e.ArenaConfig = cfg
+	e.Rand = rand.New(rand.NewSource(cfg.Seed))
+	e.Obstacles = NewObstacleStates(cfg.Obstacles)
+	e.Pickups = NewPickupStates(cfg.Pickups)
+	e.Zone = NewZoneState(cfg)
+	e.WinConditions = NewWinConditions(cfg)
+	e.Physics.Classes = classes
+	return nil
+}
+
+func newSeed() int64 {
+	return time.Now().UnixNano()
+}
+
+// Class returns the stats of a bot class in the match's class set.
+func (e *SimulationEngine) Class(name string) (*ClassDef, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	def, ok := e.Physics.Classes[name]
+	return def, ok
 }
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
 )
@@ -100,3 +102,117 @@ func TestEngine_Tick_Death(t *testing.T) {
 		t.Error("Expected Death event, not found")
 	}
 }
+
+func TestEngine_Tick_KillAttribution(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 1})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 500, Y: 300}, Hull: 100})
+	e.Bullets = []*pb.BulletState{
+		{Id: "b1", OwnerId: "bot2", Position: &pb.Vector3{X: 100, Y: 100}, Velocity: 0, Power: 3},
+	}
+
+	state := e.Tick()
+
+	var death *pb.DeathEvent
+	var hit *pb.BulletHitTargetEvent
+	for _, ev := range state.Events {
+		if d := ev.GetDeath(); d != nil {
+			death = d
+		}
+		if h := ev.GetBulletHitTarget(); h != nil {
+			hit = h
+		}
+	}
+	if death == nil || death.KillerId != "bot2" {
+		t.Errorf("Expected death credited to bot2, got %v", death)
+	}
+	if hit == nil || hit.OwnerId != "bot2" || hit.TargetId != "bot1" {
+		t.Errorf("Expected BulletHitTarget for shooter, got %v", hit)
+	}
+	if e.Stats["bot2"].Kills != 1 {
+		t.Errorf("Expected bot2 to be credited with a kill, got %d", e.Stats["bot2"].Kills)
+	}
+
+	// The shooter is told about its hit, the victim is not
+	shooterView := e.Physics.FilterStateForBot("bot2", state)
+	found := false
+	for _, ev := range shooterView.Events {
+		if ev.GetBulletHitTarget() != nil {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected BulletHitTarget to be routed to the shooter")
+	}
+}
+
+func TestEngine_Tick_ZoneKill(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.Status = pb.MatchStatus_RUNNING
+	e.Zone = &pb.ZoneState{X: 400, Y: 300, Radius: 60, Damage: 0.5}
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 0.1})
+
+	state := e.Tick()
+
+	for _, ev := range state.Events {
+		if d := ev.GetDeath(); d != nil {
+			if d.KillerId != ZoneDamageSource {
+				t.Errorf("Expected zone kill, got killer %q", d.KillerId)
+			}
+			return
+		}
+	}
+	t.Error("Expected Death event, not found")
+}
+
+func TestEngine_Tick_TeamMessages(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", TeamId: "red", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", TeamId: "red", Position: &pb.Vector3{X: 700, Y: 500}, Hull: 100})
+	e.SetBot("bot3", &pb.BotState{Id: "bot3", TeamId: "blue", Position: &pb.Vector3{X: 700, Y: 100}, Hull: 100})
+	e.SetBotIntent("bot1", &pb.BotIntent{TeamMessage: strings.Repeat("x", TeamMessageMaxLength+10)})
+
+	state := e.Tick()
+
+	var msg *pb.TeamMessageEvent
+	for _, ev := range e.Physics.FilterStateForBot("bot2", state).Events {
+		if m := ev.GetTeamMessage(); m != nil {
+			msg = m
+		}
+	}
+	if msg == nil || msg.SenderId != "bot1" {
+		t.Fatalf("Expected teammate to receive message, got %v", msg)
+	}
+	if len(msg.Message) != TeamMessageMaxLength {
+		t.Errorf("Expected message truncated to %d bytes, got %d", TeamMessageMaxLength, len(msg.Message))
+	}
+}
+
+func TestEngine_Tick_TeamMessageKeepsRunes(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", TeamId: "red", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", TeamId: "red", Position: &pb.Vector3{X: 700, Y: 500}, Hull: 100})
+	// Two-byte runes after one ASCII byte put the byte limit mid-rune
+	e.SetBotIntent("bot1", &pb.BotIntent{TeamMessage: "a" + strings.Repeat("é", 200)})
+
+	state := e.Tick()
+
+	var msg *pb.TeamMessageEvent
+	for _, ev := range state.Events {
+		if m := ev.GetTeamMessage(); m != nil {
+			msg = m
+		}
+	}
+	if msg == nil {
+		t.Fatal("Expected the message to be relayed")
+	}
+	if !utf8.ValidString(msg.Message) {
+		t.Errorf("Expected valid UTF-8 after truncation, got %q", msg.Message)
+	}
+	if len(msg.Message) != TeamMessageMaxLength-1 {
+		t.Errorf("Expected message cut to %d bytes, got %d", TeamMessageMaxLength-1, len(msg.Message))
+	}
+}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// A full world state is stored every KeyframeInterval ticks; the ticks in
+// between only store what changed.
+const KeyframeInterval = 60
+
+// DiffState returns the changes that turn prev into next.
+func DiffState(prev, next *pb.WorldState) *pb.WorldStateDelta {
+	delta := &pb.WorldStateDelta{
+		Tick:    next.Tick,
+		Status:  next.Status,
+		Bullets: next.Bullets,
+		Zone:    next.Zone,
+		Events:  next.Events,
+		Pickups: next.Pickups,
+	}
+
+	prevBots := make(map[string]*pb.BotState, len(prev.Bots))
+	for _, b := range prev.Bots {
+		prevBots[b.Id] = b
+	}
+	for _, b := range next.Bots {
+		if old, ok := prevBots[b.Id]; !ok || !proto.Equal(old, b) {
+			delta.Bots = append(delta.Bots, b)
+		}
+		delete(prevBots, b.Id)
+	}
+	for _, b := range prev.Bots {
+		if _, gone := prevBots[b.Id]; gone {
+			delta.RemovedBotIds = append(delta.RemovedBotIds, b.Id)
+		}
+	}
+
+	prevObstacles := make(map[string]*pb.ObstacleState, len(prev.Obstacles))
+	for _, o := range prev.Obstacles {
+		prevObstacles[o.Id] = o
+	}
+	for _, o := range next.Obstacles {
+		if old, ok := prevObstacles[o.Id]; !ok || !proto.Equal(old, o) {
+			delta.Obstacles = append(delta.Obstacles, o)
+		}
+		delete(prevObstacles, o.Id)
+	}
+	for _, o := range prev.Obstacles {
+		if _, gone := prevObstacles[o.Id]; gone {
+			delta.RemovedObstacleIds = append(delta.RemovedObstacleIds, o.Id)
+		}
+	}
+
+	return delta
+}
+
+// ApplyDelta rebuilds the state a delta was taken from. The base is not modified.
+func ApplyDelta(base *pb.WorldState, delta *pb.WorldStateDelta) *pb.WorldState {
+	state := &pb.WorldState{
+		Tick:    delta.Tick,
+		Status:  delta.Status,
+		Bullets: delta.Bullets,
+		Zone:    delta.Zone,
+		Events:  delta.Events,
+		Pickups: delta.Pickups,
+	}
+
+	bots := make(map[string]*pb.BotState, len(base.Bots)+len(delta.Bots))
+	for _, b := range base.Bots {
+		bots[b.Id] = b
+	}
+	for _, id := range delta.RemovedBotIds {
+		delete(bots, id)
+	}
+	for _, b := range delta.Bots {
+		bots[b.Id] = b
+	}
+	state.Bots = make([]*pb.BotState, 0, len(bots))
+	for _, b := range bots {
+		state.Bots = append(state.Bots, b)
+	}
+	// Engine states list bots by ID
+	sort.Slice(state.Bots, func(i, j int) bool { return state.Bots[i].Id < state.Bots[j].Id })
+
+	changed := make(map[string]*pb.ObstacleState, len(delta.Obstacles))
+	for _, o := range delta.Obstacles {
+		changed[o.Id] = o
+	}
+	removed := make(map[string]bool, len(delta.RemovedObstacleIds))
+	for _, id := range delta.RemovedObstacleIds {
+		removed[id] = true
+	}
+	for _, o := range base.Obstacles {
+		if removed[o.Id] {
+			continue
+		}
+		if c, ok := changed[o.Id]; ok {
+			o = c
+			delete(changed, o.Id)
+		}
+		state.Obstacles = append(state.Obstacles, o)
+	}
+	for _, o := range delta.Obstacles {
+		if _, added := changed[o.Id]; added {
+			state.Obstacles = append(state.Obstacles, o)
+		}
+	}
+
+	return state
+}
+
+// recordFrame saves the state after a tick as a keyframe or a delta against
+// the previously recorded state.
+func (e *SimulationEngine) recordFrame(state *pb.WorldState) {
+	frame := &persistence.StateFrame{
+		MatchID:  e.MatchID,
+		Tick:     state.Tick,
+		Keyframe: e.lastFrame == nil || state.Tick%KeyframeInterval == 0,
+	}
+	if frame.MatchID == "" {
+		frame.MatchID = DefaultMatchID
+	}
+
+	var msg proto.Message = state
+	if !frame.Keyframe {
+		msg = DiffState(e.lastFrame, state)
+	}
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		slog.Error("Failed to encode replay frame", "match_id", frame.MatchID, "tick", state.Tick, "error", err)
+		return
+	}
+	frame.Payload = payload
+	if err := e.DB.SaveStateFrame(frame); err != nil {
+		slog.Error("Failed to save replay frame", "match_id", frame.MatchID, "tick", state.Tick, "error", err)
+		return
+	}
+	e.lastFrame = proto.Clone(state).(*pb.WorldState)
+}
+
+// ReplayStates rebuilds the world state of every tick from startTick to
+// endTick (0 for no bound) out of stored frames, which must begin with a keyframe.
+func ReplayStates(frames []persistence.StateFrame, startTick, endTick int64) ([]*pb.WorldState, error) {
+	states := make([]*pb.WorldState, 0)
+	var current *pb.WorldState
+	for _, f := range frames {
+		if f.Keyframe {
+			current = &pb.WorldState{}
+			if err := proto.Unmarshal(f.Payload, current); err != nil {
+				return nil, fmt.Errorf("keyframe at tick %d: %w", f.Tick, err)
+			}
+		} else {
+			if current == nil {
+				return nil, fmt.Errorf("delta at tick %d has no keyframe before it", f.Tick)
+			}
+			delta := &pb.WorldStateDelta{}
+			if err := proto.Unmarshal(f.Payload, delta); err != nil {
+				return nil, fmt.Errorf("delta at tick %d: %w", f.Tick, err)
+			}
+			current = ApplyDelta(current, delta)
+		}
+
+		if current.Tick >= startTick && (endTick <= 0 || current.Tick <= endTick) {
+			states = append(states, current)
+		}
+	}
+	return states, nil
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDiffState_RoundTrip(t *testing.T) {
+	prev := &pb.WorldState{
+		Tick: 4,
+		Bots: []*pb.BotState{
+			{Id: "a", Position: &pb.Vector3{X: 10, Y: 10}, Hull: 100},
+			{Id: "b", Position: &pb.Vector3{X: 50, Y: 50}, Hull: 100},
+			{Id: "c", Position: &pb.Vector3{X: 90, Y: 90}, Hull: 5},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock", Position: &pb.Vector3{X: 200, Y: 200}, Radius: 20, Hp: 50},
+			{Id: "crate", Position: &pb.Vector3{X: 300, Y: 200}, Radius: 10, Hp: 5},
+		},
+	}
+	next := &pb.WorldState{
+		Tick:   5,
+		Status: pb.MatchStatus_RUNNING,
+		Bots: []*pb.BotState{
+			{Id: "a", Position: &pb.Vector3{X: 10, Y: 10}, Hull: 100},
+			{Id: "b", Position: &pb.Vector3{X: 55, Y: 50}, Hull: 97},
+			{Id: "d", Position: &pb.Vector3{X: 400, Y: 400}, Hull: 100},
+		},
+		Bullets: []*pb.BulletState{{Id: "bullet_a_5", OwnerId: "a", Position: &pb.Vector3{X: 20, Y: 10}}},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock", Position: &pb.Vector3{X: 200, Y: 200}, Radius: 20, Hp: 48},
+		},
+		Events: []*pb.SimulationEvent{{Tick: 5, Event: &pb.SimulationEvent_Death{Death: &pb.DeathEvent{BotId: "c"}}}},
+	}
+
+	delta := DiffState(prev, next)
+	if len(delta.Bots) != 2 {
+		t.Errorf("Expected only the moved and joined bots in the delta, got %d", len(delta.Bots))
+	}
+	if len(delta.RemovedBotIds) != 1 || delta.RemovedBotIds[0] != "c" {
+		t.Errorf("Expected bot c to be removed, got %v", delta.RemovedBotIds)
+	}
+	if len(delta.RemovedObstacleIds) != 1 || delta.RemovedObstacleIds[0] != "crate" {
+		t.Errorf("Expected the crate to be removed, got %v", delta.RemovedObstacleIds)
+	}
+
+	if rebuilt := ApplyDelta(prev, delta); !proto.Equal(rebuilt, next) {
+		t.Errorf("Rebuilt state differs:\n got %v\nwant %v", rebuilt, next)
+	}
+}
+
+func TestReplayStates_SeekMatchesLiveStates(t *testing.T) {
+	db, err := persistence.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	e := NewSimulationEngine(800, 600, db)
+	e.MatchID = "seek-match"
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 200, Y: 300}, Hull: 100, Energy: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 600, Y: 300}, Hull: 100, Energy: 100})
+
+	live := make([]*pb.WorldState, 0)
+	for i := 0; i < 150; i++ {
+		e.SetBotIntent("bot1", &pb.BotIntent{MoveDistance: 1, TurnDegrees: 2, FirePower: 1})
+		e.SetBotIntent("bot2", &pb.BotIntent{MoveDistance: -1, GunTurnDegrees: 3})
+		live = append(live, proto.Clone(e.Tick()).(*pb.WorldState))
+	}
+
+	// Seek into the middle of the second keyframe interval
+	frames, err := db.GetStateFrames("seek-match", 100, 105)
+	if err != nil {
+		t.Fatalf("Failed to load frames: %v", err)
+	}
+	if !frames[0].Keyframe || frames[0].Tick != KeyframeInterval {
+		t.Fatalf("Expected frames to start at the keyframe at tick %d, got tick %d", KeyframeInterval, frames[0].Tick)
+	}
+
+	states, err := ReplayStates(frames, 100, 105)
+	if err != nil {
+		t.Fatalf("Failed to rebuild states: %v", err)
+	}
+	if len(states) != 6 {
+		t.Fatalf("Expected 6 states, got %d", len(states))
+	}
+	for _, st := range states {
+		if want := live[st.Tick-1]; !proto.Equal(st, want) {
+			t.Errorf("Tick %d differs from the live state", st.Tick)
+		}
+	}
+}
+
+func TestReplayStates_LatestRun(t *testing.T) {
+	db, err := persistence.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// The same match ID is run twice, as the default match is after a restart
+	var live []*pb.WorldState
+	for run, x := range []float32{200, 400} {
+		e := NewSimulationEngine(800, 600, db)
+		e.MatchID = "rerun-match"
+		e.Status = pb.MatchStatus_RUNNING
+		e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: x, Y: 300}, Hull: 100, Energy: 100})
+		e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 600, Y: 100}, Hull: 100, Energy: 100})
+		live = nil
+		for i := 0; i < 60-run*20; i++ {
+			e.SetBotIntent("bot1", &pb.BotIntent{MoveDistance: 1, TurnDegrees: 2})
+			live = append(live, proto.Clone(e.Tick()).(*pb.WorldState))
+		}
+	}
+
+	for _, start := range []int64{0, 10} {
+		frames, err := db.GetStateFrames("rerun-match", start, 0)
+		if err != nil {
+			t.Fatalf("Failed to load frames: %v", err)
+		}
+		states, err := ReplayStates(frames, start, 0)
+		if err != nil {
+			t.Fatalf("Failed to rebuild states: %v", err)
+		}
+		if want := len(live) - int(max(start-1, 0)); len(states) != want {
+			t.Fatalf("Expected %d states of the latest run from tick %d, got %d", want, start, len(states))
+		}
+		for _, st := range states {
+			if !proto.Equal(st, live[st.Tick-1]) {
+				t.Errorf("Tick %d differs from the latest run", st.Tick)
+			}
+		}
+	}
+}
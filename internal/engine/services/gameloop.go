@@ -1,18 +1,32 @@
 package services
 
 import (
+	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
 )
 
+// Game loop limits
+const (
+	MaxTicksPerSecond = 1000
+	MaxStepTicks      = 10000 // Ticks a single Step may advance
+)
+
+// GameLoop ticks an engine at a fixed rate and broadcasts every new state.
+// Controls run on the loop goroutine between ticks, so they never race a tick.
 type GameLoop struct {
 	Engine    *SimulationEngine
 	Ticker    *time.Ticker
-	StopChan  chan bool
 	Broadcast func(*pb.WorldState)
 	TickRate  time.Duration
+
+	rateMu   sync.Mutex // Guards TickRate once the loop runs
+	commands chan func()
+	done     chan struct{}
 }
 
 func NewGameLoop(engine *SimulationEngine, tickRate time.Duration, broadcast func(*pb.WorldState)) *GameLoop {
@@ -22,46 +36,167 @@ func NewGameLoop(engine *SimulationEngine, tickRate time.Duration, broadcast fun
 	return &GameLoop{
 		Engine:    engine,
 		Broadcast: broadcast,
-		StopChan:  make(chan bool),
 		TickRate:  tickRate,
+		commands:  make(chan func()),
+		done:      make(chan struct{}),
 	}
 }
 
 func (gl *GameLoop) Run() {
-	gl.Ticker = time.NewTicker(gl.TickRate)
+	defer close(gl.done)
+	gl.Ticker = time.NewTicker(gl.Rate())
 	defer gl.Ticker.Stop()
 
 	for {
 		select {
-		case <-gl.StopChan:
-			return
+		case cmd := <-gl.commands:
+			cmd()
 		case <-gl.Ticker.C:
 			if gl.Engine.Status == pb.MatchStatus_RUNNING {
 				if gl.Engine.CurrentTick%60 == 0 {
 					slog.Debug("GameLoop Tick", "tick", gl.Engine.CurrentTick, "bots_count", len(gl.Engine.Bots))
 				}
-				state := gl.Engine.Tick()
-
-				// Broadcast state
-				if gl.Broadcast != nil {
-					gl.Broadcast(state)
-				}
-
-				if gl.Engine.Status == pb.MatchStatus_FINISHED {
-					return
-				}
+				gl.tick()
 			}
 		}
+		if gl.Engine.Status == pb.MatchStatus_FINISHED {
+			return
+		}
+	}
+}
+
+// tick advances the engine one tick and broadcasts the new state.
+func (gl *GameLoop) tick() {
+	state := gl.Engine.Tick()
+	if gl.Broadcast != nil {
+		gl.Broadcast(state)
+	}
+}
+
+// do runs fn on the loop goroutine and waits for its result. It fails once
+// the loop has exited.
+func (gl *GameLoop) do(fn func() error) error {
+	errc := make(chan error, 1)
+	select {
+	case gl.commands <- func() { errc <- fn() }:
+		return <-errc
+	case <-gl.done:
+		return fmt.Errorf("match %s is no longer running", gl.Engine.MatchID)
 	}
 }
 
 func (gl *GameLoop) Start() {
-	gl.Engine.Status = pb.MatchStatus_RUNNING
+	gl.Engine.setStatus(pb.MatchStatus_RUNNING)
+	gl.Engine.markStarted()
 	go gl.Run()
 }
 
+// Stop ends the match, broadcasting its final state, and waits for the loop
+// to exit. Stopping a loop that already exited does nothing.
 func (gl *GameLoop) Stop() {
-	go func() {
-		gl.StopChan <- true
-	}()
+	gl.do(func() error {
+		if final := gl.Engine.Stop(); final != nil && gl.Broadcast != nil {
+			gl.Broadcast(final)
+		}
+		return nil
+	})
+	<-gl.done
+}
+
+// control runs fn on the loop goroutine like do, and returns the match state
+// right after it, before another tick can run.
+func (gl *GameLoop) control(fn func() error) (*pb.WorldState, error) {
+	var state *pb.WorldState
+	err := gl.do(func() error {
+		if err := fn(); err != nil {
+			return err
+		}
+		state = gl.Engine.GetWorldState()
+		return nil
+	})
+	return state, err
+}
+
+// Pause freezes a running match and returns its state. Pausing a paused match
+// does nothing.
+func (gl *GameLoop) Pause() (*pb.WorldState, error) {
+	return gl.control(func() error {
+		switch status := gl.Engine.Status; status {
+		case pb.MatchStatus_RUNNING:
+			gl.Engine.setStatus(pb.MatchStatus_PAUSED)
+		case pb.MatchStatus_PAUSED:
+		default:
+			return fmt.Errorf("match %s cannot be paused while %s", gl.Engine.MatchID, status)
+		}
+		return nil
+	})
+}
+
+// Resume lets a paused match run again and returns its state. Resuming a
+// running match does nothing.
+func (gl *GameLoop) Resume() (*pb.WorldState, error) {
+	return gl.control(func() error {
+		switch status := gl.Engine.Status; status {
+		case pb.MatchStatus_PAUSED:
+			gl.Engine.setStatus(pb.MatchStatus_RUNNING)
+		case pb.MatchStatus_RUNNING:
+		default:
+			return fmt.Errorf("match %s cannot be resumed while %s", gl.Engine.MatchID, status)
+		}
+		return nil
+	})
+}
+
+// Step advances a paused match by n ticks, broadcasting each state, leaves it
+// paused and returns its state. It stops early if the match finishes. Intent
+// deadlines do not apply to stepped ticks.
+func (gl *GameLoop) Step(n int) (*pb.WorldState, error) {
+	if n < 1 || n > MaxStepTicks {
+		return nil, fmt.Errorf("step must be between 1 and %d ticks, got %d", MaxStepTicks, n)
+	}
+	return gl.control(func() error {
+		if status := gl.Engine.Status; status != pb.MatchStatus_PAUSED {
+			return fmt.Errorf("match %s must be paused to step, it is %s", gl.Engine.MatchID, status)
+		}
+		// Stepped ticks are recorded as such so replays match, and watchers
+		// see the match is still paused
+		for i := 0; i < n; i++ {
+			gl.Engine.setStatus(pb.MatchStatus_RUNNING)
+			state := gl.Engine.StepTick()
+			finished := gl.Engine.GetStatus() == pb.MatchStatus_FINISHED
+			if !finished {
+				gl.Engine.setStatus(pb.MatchStatus_PAUSED)
+				state = proto.Clone(state).(*pb.WorldState)
+				state.Status = pb.MatchStatus_PAUSED
+			}
+			if gl.Broadcast != nil {
+				gl.Broadcast(state)
+			}
+			if finished {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+// SetTickRate changes the time between ticks and returns the match state.
+func (gl *GameLoop) SetTickRate(rate time.Duration) (*pb.WorldState, error) {
+	if rate < time.Second/MaxTicksPerSecond {
+		return nil, fmt.Errorf("tick rate %v is faster than the maximum of %d ticks per second", rate, MaxTicksPerSecond)
+	}
+	return gl.control(func() error {
+		gl.rateMu.Lock()
+		gl.TickRate = rate
+		gl.rateMu.Unlock()
+		gl.Ticker.Reset(rate)
+		return nil
+	})
+}
+
+// Rate returns the time between ticks.
+func (gl *GameLoop) Rate() time.Duration {
+	gl.rateMu.Lock()
+	defer gl.rateMu.Unlock()
+	return gl.TickRate
 }
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestGameLoop_PauseStepResume(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 700, Y: 500}, Hull: 100})
+
+	var broadcasts []*pb.WorldState
+	gl := NewGameLoop(e, time.Hour, func(st *pb.WorldState) { broadcasts = append(broadcasts, st) })
+	gl.Start()
+	defer gl.Stop()
+
+	if _, err := gl.Step(1); err == nil {
+		t.Error("Expected stepping a running match to fail")
+	}
+	if _, err := gl.Pause(); err != nil {
+		t.Fatalf("Failed to pause: %v", err)
+	}
+	if _, err := gl.Step(3); err != nil {
+		t.Fatalf("Failed to step: %v", err)
+	}
+	if e.CurrentTick != 3 || len(broadcasts) != 3 {
+		t.Errorf("Expected 3 stepped and broadcast ticks, got tick %d and %d broadcasts", e.CurrentTick, len(broadcasts))
+	}
+	if e.Status != pb.MatchStatus_PAUSED {
+		t.Errorf("Expected the match to stay paused, got %s", e.Status)
+	}
+	for _, st := range broadcasts {
+		if st.Status != pb.MatchStatus_PAUSED {
+			t.Errorf("Expected stepped tick %d to be broadcast as paused, got %s", st.Tick, st.Status)
+		}
+	}
+	if _, err := gl.Step(0); err == nil {
+		t.Error("Expected an error for a zero step")
+	}
+
+	if _, err := gl.SetTickRate(time.Microsecond); err == nil {
+		t.Error("Expected an error above the maximum tick rate")
+	}
+	if _, err := gl.SetTickRate(time.Minute); err != nil || gl.Rate() != time.Minute {
+		t.Errorf("Expected a one minute tick rate, got %v (%v)", gl.Rate(), err)
+	}
+
+	if _, err := gl.Resume(); err != nil {
+		t.Fatalf("Failed to resume: %v", err)
+	}
+	if e.Status != pb.MatchStatus_RUNNING {
+		t.Errorf("Expected the match to run again, got %s", e.Status)
+	}
+}
+
+func TestGameLoop_StopPersistsResult(t *testing.T) {
+	db, _ := persistence.NewDatabase(":memory:")
+	e := NewSimulationEngine(800, 600, db)
+	e.MatchID = "stopped"
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 700, Y: 500}, Hull: 40})
+
+	var final *pb.WorldState
+	gl := NewGameLoop(e, time.Hour, func(st *pb.WorldState) { final = st })
+	gl.Start()
+	gl.Stop()
+
+	if final == nil || final.Status != pb.MatchStatus_FINISHED {
+		t.Fatalf("Expected a FINISHED final state, got %v", final)
+	}
+	var finished *pb.MatchFinishedEvent
+	for _, ev := range final.Events {
+		if f := ev.GetMatchFinished(); f != nil {
+			finished = f
+		}
+	}
+	if finished == nil || finished.WinnerId != "bot1" {
+		t.Errorf("Expected bot1 to win on hull, got %v", finished)
+	}
+	if _, err := gl.Pause(); err == nil {
+		t.Error("Expected controls to fail once the loop has stopped")
+	}
+
+	matches, _ := db.ListMatches()
+	if len(matches) != 1 || matches[0].Status != "FINISHED" || matches[0].WinnerID != "bot1" {
+		t.Errorf("Expected the stopped match to be persisted with its winner, got %v", matches)
+	}
+}
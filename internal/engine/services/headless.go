@@ -0,0 +1,83 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// HeadlessBot is a bot taking part in a headless match.
+type HeadlessBot struct {
+	Name       string
+	Class      string // Empty selects a Tank
+	TeamID     string
+	Controller BotController
+}
+
+// HeadlessResult summarizes a headless match.
+type HeadlessResult struct {
+	MatchID  string
+	WinnerID string // Winning bot or team, empty on a draw
+	Ticks    int64
+	Stats    []BotStats
+}
+
+// RunHeadless simulates a match as fast as the CPU allows, without a game
+// loop or network. Bots join in order as bot_1, bot_2, ..., the IDs anonymous
+// bots get in live matches, followed by the arena's builtin bots. The match
+// ends when a win condition is met or, after maxTicks, by the arena's
+// tiebreak. With a database the match is recorded like a live one, so it can
+// be replayed and verified.
+func RunHeadless(arena *pb.ArenaConfig, bots []HeadlessBot, maxTicks int64, db *persistence.Database) (*HeadlessResult, error) {
+	if maxTicks <= 0 {
+		return nil, fmt.Errorf("max ticks must be positive, got %d", maxTicks)
+	}
+	arena = proto.Clone(arena).(*pb.ArenaConfig)
+	if err := ValidateArenaConfig(arena); err != nil {
+		return nil, fmt.Errorf("invalid arena config: %w", err)
+	}
+
+	e := NewSimulationEngine(arena.Width, arena.Height, db)
+	if err := e.SetArenaConfig(arena); err != nil {
+		return nil, err
+	}
+	e.MatchID = arena.Id
+	if e.MatchID == "" {
+		e.MatchID = fmt.Sprintf("sim_%d", arena.Seed)
+	}
+
+	for i, b := range bots {
+		id := fmt.Sprintf("bot_%d", i+1)
+		if _, err := e.Join(id, b.Name, b.Class, b.TeamID); err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		if b.Controller != nil {
+			e.Attach(id, b.Controller)
+		}
+	}
+	if err := e.JoinBuiltinBots(); err != nil {
+		return nil, err
+	}
+
+	e.Status = pb.MatchStatus_RUNNING
+	final := e.GetWorldState()
+	for e.Status != pb.MatchStatus_FINISHED && e.CurrentTick < maxTicks {
+		final = e.Tick()
+	}
+	if e.Status != pb.MatchStatus_FINISHED {
+		final = e.Stop()
+	}
+
+	result := &HeadlessResult{MatchID: e.MatchID, Ticks: e.CurrentTick}
+	for _, ev := range final.Events {
+		if f := ev.GetMatchFinished(); f != nil {
+			result.WinnerID = f.WinnerId
+		}
+	}
+	for _, id := range e.sortedStatIDs() {
+		result.Stats = append(result.Stats, *e.Stats[id])
+	}
+	return result, nil
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// turret aims at the first enemy it sees and fires at full power.
+type turret struct{}
+
+func (turret) Intent(view *pb.WorldState) *pb.BotIntent {
+	if len(view.Bots) < 2 {
+		return &pb.BotIntent{RadarTurnDegrees: 45}
+	}
+	self, target := view.Bots[0], view.Bots[1]
+	bearing := math.Atan2(float64(target.Position.X-self.Position.X), float64(self.Position.Y-target.Position.Y)) * 180 / math.Pi
+	turn := math.Mod(bearing-float64(self.GunHeading)+540, 360) - 180
+	return &pb.BotIntent{GunTurnDegrees: float32(turn), RadarTurnDegrees: 45, FirePower: MaxFirePower}
+}
+
+func headlessArena() *pb.ArenaConfig {
+	return &pb.ArenaConfig{Width: 800, Height: 600, Seed: 11, MatchDurationTicks: 2000}
+}
+
+func TestRunHeadless_Deterministic(t *testing.T) {
+	bots := []HeadlessBot{{Name: "a", Controller: turret{}}, {Name: "b", Controller: turret{}}}
+
+	first, err := RunHeadless(headlessArena(), bots, 5000, nil)
+	if err != nil {
+		t.Fatalf("RunHeadless failed: %v", err)
+	}
+	second, _ := RunHeadless(headlessArena(), bots, 5000, nil)
+
+	if first.Ticks == 0 || first.Ticks != second.Ticks || first.WinnerID != second.WinnerID {
+		t.Errorf("Expected identical runs, got %+v and %+v", first, second)
+	}
+	if len(first.Stats) != 2 || first.Stats[0].Name != "a" || first.Stats[1].BotID != "bot_2" {
+		t.Errorf("Expected stats for bot_1 and bot_2, got %+v", first.Stats)
+	}
+	if first.Stats[0].DamageDealt == 0 && first.Stats[1].DamageDealt == 0 {
+		t.Error("Expected the turrets to hit each other")
+	}
+}
+
+func TestRunHeadless_MaxTicks(t *testing.T) {
+	bots := []HeadlessBot{{Name: "idle"}, {Name: "idle"}}
+	result, err := RunHeadless(headlessArena(), bots, 50, nil)
+	if err != nil {
+		t.Fatalf("RunHeadless failed: %v", err)
+	}
+	if result.Ticks != 50 || result.WinnerID != "" {
+		t.Errorf("Expected a draw after 50 ticks, got %+v", result)
+	}
+
+	if _, err := RunHeadless(headlessArena(), bots, 0, nil); err == nil {
+		t.Error("Expected an error without a tick limit")
+	}
+	if _, err := RunHeadless(headlessArena(), []HeadlessBot{{Class: "Juggernaut"}}, 10, nil); err == nil {
+		t.Error("Expected an error for an unknown class")
+	}
+}
+
+// greeter idles and keeps the join handshakes it is shown.
+type greeter struct {
+	handshakes []*pb.JoinAccepted
+}
+
+func (g *greeter) Intent(view *pb.WorldState) *pb.BotIntent {
+	if view.JoinAccepted != nil {
+		g.handshakes = append(g.handshakes, view.JoinAccepted)
+	}
+	return nil
+}
+
+func TestRunHeadless_BuiltinBots(t *testing.T) {
+	g := &greeter{}
+	RegisterController("test-turret", func() BotController { return turret{} })
+	RegisterController("test-greeter", func() BotController { return g })
+	t.Cleanup(func() {
+		UnregisterController("test-turret")
+		UnregisterController("test-greeter")
+	})
+
+	arena := headlessArena()
+	arena.BuiltinBots = []*pb.BuiltinBot{{Ai: "test-greeter", Name: "host"}, {Ai: "test-turret"}}
+	result, err := RunHeadless(arena, []HeadlessBot{{Name: "guest", Controller: turret{}}}, 100, nil)
+	if err != nil {
+		t.Fatalf("RunHeadless failed: %v", err)
+	}
+
+	names := map[string]string{}
+	for _, st := range result.Stats {
+		names[st.BotID] = st.Name
+	}
+	expected := map[string]string{"bot_1": "guest", "builtin_1": "host", "builtin_2": "test-turret"}
+	for id, name := range expected {
+		if names[id] != name {
+			t.Errorf("Expected %s to be named %q, got %q", id, name, names[id])
+		}
+	}
+	if len(g.handshakes) != 1 || g.handshakes[0].BotId != "builtin_1" || g.handshakes[0].Arena.Width != 800 {
+		t.Errorf("Expected one handshake for builtin_1, got %v", g.handshakes)
+	}
+
+	arena.BuiltinBots = []*pb.BuiltinBot{{Ai: "no-such-ai"}}
+	if _, err := RunHeadless(arena, nil, 10, nil); err == nil {
+		t.Error("Expected an error for an unknown AI")
+	}
+}
+
+func TestRunHeadless_RecordAndReplayIntents(t *testing.T) {
+	db, err := persistence.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	arena := headlessArena()
+	arena.Id = "recorded"
+	bots := []HeadlessBot{{Name: "a", Controller: turret{}}, {Name: "b", Controller: turret{}}}
+	original, err := RunHeadless(arena, bots, 300, db)
+	if err != nil {
+		t.Fatalf("RunHeadless failed: %v", err)
+	}
+
+	records, _ := LoadTickRecords(db, "recorded")
+	if ticks, err := VerifyReplay(records); err != nil || int64(ticks) != original.Ticks {
+		t.Fatalf("Expected the recording to verify over %d ticks, got %d (%v)", original.Ticks, ticks, err)
+	}
+
+	// Replaying both bots' intents reproduces the match
+	var replayed []HeadlessBot
+	for _, id := range []string{"bot_1", "bot_2"} {
+		ctrl, err := NewRecordedController(records, id)
+		if err != nil {
+			t.Fatalf("Failed to load %s: %v", id, err)
+		}
+		replayed = append(replayed, HeadlessBot{Name: id, Controller: ctrl})
+	}
+	again, _ := RunHeadless(headlessArena(), replayed, 300, nil)
+	for i := range again.Stats {
+		if again.Stats[i].DamageDealt != original.Stats[i].DamageDealt {
+			t.Errorf("Expected %s to deal %v damage again, got %v", again.Stats[i].BotID, original.Stats[i].DamageDealt, again.Stats[i].DamageDealt)
+		}
+	}
+	if _, err := NewRecordedController(records, "nobody"); err == nil {
+		t.Error("Expected an error for a bot without recorded intents")
+	}
+}
+
+func TestLoadArenaFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "map.yaml")
+	err := os.WriteFile(path, []byte(`
+width: 1000
+height: 800
+seed: 7
+spawn_points:
+  - position: {x: 100, y: 100}
+    team_id: red
+obstacles:
+  - id: rock
+    position: {x: 500, y: 400}
+    radius: 40
+`), 0o644)
+	if err != nil {
+		t.Fatalf("Failed to write arena: %v", err)
+	}
+
+	cfg, err := LoadArenaFile(path)
+	if err != nil {
+		t.Fatalf("Failed to load arena: %v", err)
+	}
+	if cfg.Width != 1000 || cfg.Seed != 7 || len(cfg.SpawnPoints) != 1 || cfg.SpawnPoints[0].TeamId != "red" || cfg.Obstacles[0].Radius != 40 {
+		t.Errorf("Unexpected arena: %v", cfg)
+	}
+
+	if err := os.WriteFile(path, []byte("width: wide\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write arena: %v", err)
+	}
+	if _, err := LoadArenaFile(path); err == nil {
+		t.Error("Expected an error for a malformed arena")
+	}
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateIntents checks this tick's intents against the robots' classes
+// before physics runs. Out-of-range values are clamped or dropped and each
+// change is reported to its bot as an IntentWarning event. The given intents
+// are not modified.
+func (pe *PhysicsEngine) validateIntents(robots []*pb.BotState, intents map[string]*pb.BotIntent, newState *pb.WorldState) map[string]*pb.BotIntent {
+	valid := make(map[string]*pb.BotIntent, len(intents))
+	for _, robot := range robots {
+		intent := intents[robot.Id]
+		if intent == nil {
+			continue
+		}
+		checked, warnings := validateIntent(robot, intent, pe.Classes.Get(robot.Class))
+		valid[robot.Id] = checked
+		for _, w := range warnings {
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick:  newState.Tick,
+				Event: &pb.SimulationEvent_IntentWarning{IntentWarning: w},
+			})
+		}
+	}
+	return valid
+}
+
+// validateIntent returns a copy of intent that respects the robot's class
+// limits, with a warning for every value that had to change.
+func validateIntent(robot *pb.BotState, intent *pb.BotIntent, class *ClassDef) (*pb.BotIntent, []*pb.IntentWarningEvent) {
+	valid := proto.Clone(intent).(*pb.BotIntent)
+	var warnings []*pb.IntentWarningEvent
+	warn := func(field string, requested, applied float32, reason string) {
+		warnings = append(warnings, &pb.IntentWarningEvent{
+			BotId:     robot.Id,
+			Field:     field,
+			Requested: requested,
+			Applied:   applied,
+			Reason:    reason,
+		})
+	}
+
+	fields := []struct {
+		name  string
+		value *float32
+		limit float32 // 0 = unlimited
+	}{
+		{"move_distance", &valid.MoveDistance, 0},
+		{"turn_degrees", &valid.TurnDegrees, class.TurnLimit(robot.Velocity)},
+		{"gun_turn_degrees", &valid.GunTurnDegrees, class.MaxGunTurnRate},
+		{"radar_turn_degrees", &valid.RadarTurnDegrees, class.MaxRadarTurnRate},
+		{"fire_power", &valid.FirePower, 0},
+	}
+	for _, f := range fields {
+		v := *f.value
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			*f.value = 0
+			warn(f.name, v, 0, "not a finite number")
+			continue
+		}
+		if f.limit > 0 && (v > f.limit || v < -f.limit) {
+			*f.value = float32(math.Copysign(float64(f.limit), float64(v)))
+			warn(f.name, v, *f.value, fmt.Sprintf("exceeds the class limit of %g per tick", f.limit))
+		}
+	}
+
+	switch power := valid.FirePower; {
+	case power < 0:
+		valid.FirePower = 0
+		warn("fire_power", power, 0, "must not be negative")
+	case power > 0 && power < MinFirePower:
+		valid.FirePower = MinFirePower
+		warn("fire_power", power, MinFirePower, fmt.Sprintf("below the minimum of %g", MinFirePower))
+	case power > MaxFirePower:
+		valid.FirePower = MaxFirePower
+		warn("fire_power", power, MaxFirePower, fmt.Sprintf("above the maximum of %g", float32(MaxFirePower)))
+	}
+	if valid.FirePower > 0 && !class.CanFire(valid.Weapon) {
+		warn("fire_power", valid.FirePower, 0, fmt.Sprintf("class cannot fire %s", valid.Weapon))
+		valid.FirePower = 0
+	}
+
+	if valid.UsePower != pb.PowerType_POWER_NONE {
+		if _, known := LookupPower(valid.UsePower); !known {
+			warn("use_power", float32(valid.UsePower), 0, "unknown power")
+			valid.UsePower = pb.PowerType_POWER_NONE
+		}
+	}
+
+	return valid, warnings
+}
+
+// stoppingSpeed returns the highest speed from which a robot braking by decel
+// per tick can still stop within distance: v + (v-decel) + ... <= distance.
+func stoppingSpeed(distance, decel float32) float32 {
+	d, b := float64(distance), float64(decel)
+	return float32(math.Min(d, (math.Sqrt(b*b+8*b*d)-b)/2))
+}
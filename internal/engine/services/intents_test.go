@@ -0,0 +1,114 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestValidateIntent(t *testing.T) {
+	tank := NewPhysicsEngine().Classes.Get(ClassTank)
+	sniper := NewPhysicsEngine().Classes.Get(ClassSniper)
+
+	tests := []struct {
+		name      string
+		class     *ClassDef
+		velocity  float32
+		intent    *pb.BotIntent
+		field     string
+		want      float32
+		wantWarns int
+	}{
+		{name: "Turn within limit", class: tank, intent: &pb.BotIntent{TurnDegrees: 5}, field: "turn_degrees", want: 5},
+		{name: "Turn clamped at rest", class: tank, intent: &pb.BotIntent{TurnDegrees: 180}, field: "turn_degrees", want: tank.MaxTurnRate, wantWarns: 1},
+		{name: "Turn slower at speed", class: tank, velocity: -4, intent: &pb.BotIntent{TurnDegrees: -90}, field: "turn_degrees", want: -(tank.MaxTurnRate - 4*tank.TurnRatePerSpeed), wantWarns: 1},
+		{name: "Gun turn clamped", class: tank, intent: &pb.BotIntent{GunTurnDegrees: 90}, field: "gun_turn_degrees", want: tank.MaxGunTurnRate, wantWarns: 1},
+		{name: "Radar turn clamped", class: tank, intent: &pb.BotIntent{RadarTurnDegrees: -360}, field: "radar_turn_degrees", want: -tank.MaxRadarTurnRate, wantWarns: 1},
+		{name: "Fire power above maximum", class: tank, intent: &pb.BotIntent{FirePower: 10}, field: "fire_power", want: MaxFirePower, wantWarns: 1},
+		{name: "Fire power below minimum", class: tank, intent: &pb.BotIntent{FirePower: 0.01}, field: "fire_power", want: MinFirePower, wantWarns: 1},
+		{name: "Negative fire power", class: tank, intent: &pb.BotIntent{FirePower: -1}, field: "fire_power", want: 0, wantWarns: 1},
+		{name: "Weapon the class lacks", class: sniper, intent: &pb.BotIntent{FirePower: 1, Weapon: pb.WeaponType_MINE}, field: "fire_power", want: 0, wantWarns: 1},
+		{name: "Non-finite distance", class: tank, intent: &pb.BotIntent{MoveDistance: float32(math.Inf(1))}, field: "move_distance", want: 0, wantWarns: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			robot := &pb.BotState{Id: "bot1", Velocity: tt.velocity}
+			valid, warnings := validateIntent(robot, tt.intent, tt.class)
+
+			got := map[string]float32{
+				"move_distance":      valid.MoveDistance,
+				"turn_degrees":       valid.TurnDegrees,
+				"gun_turn_degrees":   valid.GunTurnDegrees,
+				"radar_turn_degrees": valid.RadarTurnDegrees,
+				"fire_power":         valid.FirePower,
+			}[tt.field]
+			if got != tt.want {
+				t.Errorf("%s = %f, want %f", tt.field, got, tt.want)
+			}
+			if len(warnings) != tt.wantWarns {
+				t.Fatalf("Expected %d warnings, got %v", tt.wantWarns, warnings)
+			}
+			for _, w := range warnings {
+				if w.BotId != "bot1" || w.Field != tt.field || w.Applied != tt.want {
+					t.Errorf("Unexpected warning %v", w)
+				}
+			}
+		})
+	}
+}
+
+func TestPhysicsUpdate_IntentWarningsReachOnlyTheBot(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	intent := &pb.BotIntent{TurnDegrees: 180}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "bot1", Position: &pb.Vector3{X: 200, Y: 300}, Hull: 100},
+		{Id: "bot2", Position: &pb.Vector3{X: 600, Y: 300}, Hull: 100},
+	}}
+
+	newState := pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": intent})
+
+	if intent.TurnDegrees != 180 {
+		t.Errorf("The caller's intent should not be modified, got %f", intent.TurnDegrees)
+	}
+	if heading := newState.Bots[0].Heading; heading != pe.Classes.Get("").MaxTurnRate {
+		t.Errorf("Expected the turn to be clamped, heading=%f", heading)
+	}
+
+	countWarnings := func(state *pb.WorldState) int {
+		n := 0
+		for _, ev := range state.Events {
+			if ev.GetIntentWarning() != nil {
+				n++
+			}
+		}
+		return n
+	}
+	if n := countWarnings(pe.FilterStateForBot("bot1", newState)); n != 1 {
+		t.Errorf("Expected bot1 to see its warning, got %d", n)
+	}
+	if n := countWarnings(pe.FilterStateForBot("bot2", newState)); n != 0 {
+		t.Errorf("Expected bot2 to see no warnings, got %d", n)
+	}
+}
+
+func TestUpdateRobot_MoveDistance(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	robot := &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 400, Y: 500}, Hull: 100}
+
+	// One intent sets the target; the robot covers it over the following ticks
+	robot, _ = pe.updateRobot(robot, arena, &pb.BotIntent{MoveDistance: 50})
+	for i := 0; i < 40; i++ {
+		robot, _ = pe.updateRobot(robot, arena, nil)
+	}
+
+	if moved := 500 - robot.Position.Y; math.Abs(float64(moved-50)) > 1 {
+		t.Errorf("Expected the robot to travel 50, got %f", moved)
+	}
+	if robot.Velocity != 0 || robot.DistanceRemaining != 0 {
+		t.Errorf("Expected the robot to stop, velocity=%f remaining=%f", robot.Velocity, robot.DistanceRemaining)
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"math"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// NewObstacleStates builds the initial runtime state of the arena obstacles.
+func NewObstacleStates(obstacles []*pb.Obstacle) []*pb.ObstacleState {
+	states := make([]*pb.ObstacleState, 0, len(obstacles))
+	for _, o := range obstacles {
+		hp := o.Hp
+		if hp <= 0 {
+			hp = ObstacleDefaultHP
+		}
+		states = append(states, &pb.ObstacleState{
+			Id:             o.Id,
+			Position:       &pb.Vector3{X: o.Position.X, Y: o.Position.Y, Z: 0},
+			Radius:         o.Radius,
+			Indestructible: o.Indestructible,
+			Hp:             hp,
+		})
+	}
+	return states
+}
+
+// resolveObstacleCollision pushes a robot of the given radius out of any obstacle
+// it overlaps, keeps it inside the arena and stops it. A push can land the robot
+// in a wall or another obstacle, so it repeats until nothing overlaps or
+// ObstacleResolvePasses runs out. It reports whether a collision happened.
+func resolveObstacleCollision(robot *pb.BotState, radius float32, obstacles []*pb.ObstacleState, arena *pb.ArenaConfig) bool {
+	collided := false
+	for pass := 0; pass < ObstacleResolvePasses; pass++ {
+		pushed := false
+		for _, o := range obstacles {
+			dx := float64(robot.Position.X - o.Position.X)
+			dy := float64(robot.Position.Y - o.Position.Y)
+			dist := math.Sqrt(dx*dx + dy*dy)
+			minDist := float64(o.Radius + radius)
+
+			if dist >= minDist {
+				continue
+			}
+
+			// Robot centered on the obstacle: push it north
+			angle := -math.Pi / 2
+			if dist > 0 {
+				angle = math.Atan2(dy, dx)
+			}
+			robot.Position.X = o.Position.X + float32(math.Cos(angle)*minDist)
+			robot.Position.Y = o.Position.Y + float32(math.Sin(angle)*minDist)
+			pushed = true
+		}
+		if !pushed {
+			break
+		}
+		robot.Position.X = float32(math.Max(float64(radius), math.Min(float64(arena.Width-radius), float64(robot.Position.X))))
+		robot.Position.Y = float32(math.Max(float64(radius), math.Min(float64(arena.Height-radius), float64(robot.Position.Y))))
+		robot.Velocity = 0
+		collided = true
+	}
+	return collided
+}
+
+// segmentCircleIntersection returns the fraction t in [0, 1] along the segment
+// from (x1, y1) to (x2, y2) where it first touches the circle, or -1 if it does not.
+func segmentCircleIntersection(x1, y1, x2, y2, cx, cy, r float32) float32 {
+	dx := float64(x2 - x1)
+	dy := float64(y2 - y1)
+	fx := float64(x1 - cx)
+	fy := float64(y1 - cy)
+
+	c := fx*fx + fy*fy - float64(r*r)
+	if c <= 0 {
+		return 0 // Segment starts inside the circle
+	}
+
+	a := dx*dx + dy*dy
+	if a == 0 {
+		return -1
+	}
+	b := 2 * (fx*dx + fy*dy)
+	disc := b*b - 4*a*c
+	if disc < 0 {
+		return -1
+	}
+
+	t := (-b - math.Sqrt(disc)) / (2 * a)
+	if t < 0 || t > 1 {
+		return -1
+	}
+	return float32(t)
+}
+
+// firstObstacleHit returns the obstacle a segment hits first, or nil.
+func firstObstacleHit(x1, y1, x2, y2 float32, obstacles []*pb.ObstacleState) *pb.ObstacleState {
+	hit, _ := firstObstacleHitAt(x1, y1, x2, y2, obstacles)
+	return hit
+}
+
+// firstObstacleHitAt is firstObstacleHit that also returns the fraction along
+// the segment where the hit happens.
+func firstObstacleHitAt(x1, y1, x2, y2 float32, obstacles []*pb.ObstacleState) (*pb.ObstacleState, float32) {
+	var hit *pb.ObstacleState
+	best := float32(2)
+	for _, o := range obstacles {
+		t := segmentCircleIntersection(x1, y1, x2, y2, o.Position.X, o.Position.Y, o.Radius)
+		if t >= 0 && t < best {
+			best = t
+			hit = o
+		}
+	}
+	return hit, best
+}
+
+// lineOfSightBlocked reports whether any obstacle lies between two points.
+func lineOfSightBlocked(from, to *pb.Vector3, obstacles []*pb.ObstacleState) bool {
+	return firstObstacleHit(from.X, from.Y, to.X, to.Y, obstacles) != nil
+}
@@ -1,18 +1,21 @@
 package services
 
 import (
-	"fmt"
 	"log"
 	"math"
 
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 // PhysicsEngine handles the simulation of movement, combat, and environment rules.
-type PhysicsEngine struct{}
+type PhysicsEngine struct {
+	Classes ClassSet // Stats of the bot classes in play
+}
 
 func NewPhysicsEngine() *PhysicsEngine {
-	return &PhysicsEngine{}
+	classes, _ := DefaultClasses.Set(DefaultClassSet)
+	return &PhysicsEngine{Classes: classes}
 }
 
 // UpdatePhysics simulates one tick of the world
@@ -24,6 +27,18 @@ func (pe *PhysicsEngine) Update(state *pb.WorldState, arenaConfig *pb.ArenaConfi
 		Events:  make([]*pb.SimulationEvent, 0),
 	}
 
+	// Clamp intents to the robots' class limits before anything moves
+	intents = pe.validateIntents(state.Bots, intents, newState)
+
+	// Copy obstacles so destructible damage does not mutate the previous state
+	obstacles := make([]*pb.ObstacleState, 0, len(state.Obstacles))
+	for _, o := range state.Obstacles {
+		obstacles = append(obstacles, proto.Clone(o).(*pb.ObstacleState))
+	}
+
+	// Who last damaged each robot this tick, for kill credit
+	sources := make(damageSources)
+
 	// 0. Initialize Quadtree for optimizations
 	qtBoundary := Rectangle{X: arenaConfig.Width / 2, Y: arenaConfig.Height / 2, W: arenaConfig.Width / 2, H: arenaConfig.Height / 2}
 	qt := NewQuadtree(qtBoundary, 4)
@@ -31,23 +46,45 @@ func (pe *PhysicsEngine) Update(state *pb.WorldState, arenaConfig *pb.ArenaConfi
 		qt.Insert(b)
 	}
 
-	// 1. Update Zone (Shrink)
+	// 1. Update Zone (Shrink along the schedule)
 	if state.Zone != nil {
-		newState.Zone = &pb.ZoneState{
-			X:      state.Zone.X,
-			Y:      state.Zone.Y,
-			Radius: state.Zone.Radius - 0.05, // Shrink rate
-		}
-		if newState.Zone.Radius < 50.0 {
-			newState.Zone.Radius = 50.0 // Min radius
-		}
+		newState.Zone = advanceZone(state.Zone, arenaConfig)
 	}
 
 	// 2. Update Robots & Handle Firing & Zone Damage
+	// The map is for lookups only; iterate robotOrder so every run resolves
+	// robots in the same order
 	activeRobotsMap := make(map[string]*pb.BotState)
+	robotOrder := make([]string, 0, len(state.Bots))
+	var maxMove float32 // Furthest any robot moved, for swept bullet queries
+	var activations []*pb.BotState
 	for _, robot := range state.Bots {
 		intent := intents[robot.Id]
-		updatedRobot := pe.updateRobot(robot, arenaConfig, intent)
+		class := pe.Classes.Get(robot.Class)
+		updatedRobot, powerChanges := pe.updateRobot(robot, arenaConfig, intent)
+		for _, power := range powerChanges.expired {
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_PowerExpired{
+					PowerExpired: &pb.PowerExpiredEvent{BotId: updatedRobot.Id, Power: power},
+				},
+			})
+		}
+		if powerChanges.activated != pb.PowerType_POWER_NONE {
+			activations = append(activations, updatedRobot)
+		}
+		hitObstacle := resolveObstacleCollision(updatedRobot, class.Radius, obstacles, arenaConfig)
+
+		// Only report bumps the robot actually drove into
+		moving := robot.Velocity != 0 || (intent != nil && intent.MoveDistance != 0)
+		if moving && (hitObstacle || pe.atWall(updatedRobot, class.Radius, arenaConfig)) {
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_HitWall{
+					HitWall: &pb.HitWallEvent{BotId: updatedRobot.Id},
+				},
+			})
+		}
 
 		// Zone Damage (Shrink)
 		if newState.Zone != nil {
@@ -56,156 +93,171 @@ func (pe *PhysicsEngine) Update(state *pb.WorldState, arenaConfig *pb.ArenaConfi
 			dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
 
 			if dist > newState.Zone.Radius {
-				pe.applyDamage(updatedRobot, 0.5, newState)
+				pe.applyDamage(updatedRobot, newState.Zone.Damage, ZoneDamageSource, sources)
 			}
 		}
 
 		// Arena Zones (Functional)
-		for _, zone := range arenaConfig.Zones {
-			dx := updatedRobot.Position.X - zone.Position.X
-			dy := updatedRobot.Position.Y - zone.Position.Y
-			dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
-
-			if dist <= zone.Radius {
-				switch zone.Type {
-				case "HEAL":
-					updatedRobot.Hull = float32(math.Min(100.0, float64(updatedRobot.Hull+HealZoneAmount)))
-				case "ENERGY":
-					updatedRobot.Energy += EnergyZoneAmount
-				case "HAZARD":
-					pe.applyDamage(updatedRobot, HazardZoneDamage, newState)
-				}
-
-				newState.Events = append(newState.Events, &pb.SimulationEvent{
-					Tick: newState.Tick,
-					Event: &pb.SimulationEvent_ZoneEntered{
-						ZoneEntered: &pb.ZoneEnteredEvent{
-							BotId:  updatedRobot.Id,
-							ZoneId: zone.Id,
-							Type:   zone.Type,
-						},
-					},
-				})
-			}
-		}
+		pe.updateZoneOccupancy(updatedRobot, robot.ZoneIds, class, arenaConfig, sources, newState)
 
 		// Handle Firing
-		if intent != nil && intent.FirePower > 0 && updatedRobot.Heat <= 0 && updatedRobot.Energy >= intent.FirePower {
-			bulletID := fmt.Sprintf("bullet_%s_%d", robot.Id, newState.Tick)
-			newState.Bullets = append(newState.Bullets, &pb.BulletState{
-				Id:       bulletID,
-				OwnerId:  robot.Id,
-				Position: &pb.Vector3{X: updatedRobot.Position.X, Y: updatedRobot.Position.Y, Z: 0},
-				Heading:  updatedRobot.GunHeading,
-				Velocity: 20.0,
-				Power:    intent.FirePower,
-			})
-			updatedRobot.Heat += 1.0 + (intent.FirePower / 5.0)
-			updatedRobot.Energy -= intent.FirePower
-		}
+		pe.fire(updatedRobot, intent, class, newState)
 
 		if updatedRobot.Heat > 0 {
-			updatedRobot.Heat -= 0.1
+			updatedRobot.Heat -= class.GunCooling
 			if updatedRobot.Heat < 0 {
 				updatedRobot.Heat = 0
 			}
 		}
 
 		if updatedRobot.Hull <= 0 {
-			pe.processDeath(updatedRobot, newState)
+			pe.processDeath(updatedRobot, sources, newState)
 			continue
 		}
 
 		activeRobotsMap[updatedRobot.Id] = updatedRobot
+		robotOrder = append(robotOrder, updatedRobot.Id)
+		maxMove = float32(math.Max(float64(maxMove), math.Hypot(
+			float64(updatedRobot.Position.X-robot.Position.X), float64(updatedRobot.Position.Y-robot.Position.Y))))
 	}
 
-	// 3. Update Bullets & Collision Detection (Bullet vs Robot via Quadtree)
-	finalBullets := make([]*pb.BulletState, 0)
-	for _, bullet := range state.Bullets {
-		newX := bullet.Position.X + float32(math.Sin(float64(bullet.Heading*math.Pi/180.0)))*bullet.Velocity
-		newY := bullet.Position.Y - float32(math.Cos(float64(bullet.Heading*math.Pi/180.0)))*bullet.Velocity
+	// Area powers reach the other robots once everyone has moved
+	for _, user := range activations {
+		emitPowerActivation(user, intents[user.Id].UsePower, activeRobotsMap, robotOrder, newState)
+	}
 
-		hit := false
-		bulletRange := Rectangle{X: newX, Y: newY, W: RobotRadius, H: RobotRadius}
-		var nearbyBots []*pb.BotState
-		qt.Query(bulletRange, &nearbyBots)
+	// Quadtree queries must reach the centre of the largest robot
+	maxRadius := pe.Classes.MaxRadius()
 
-		for _, robotInOldState := range nearbyBots {
-			if robotInOldState.Id == bullet.OwnerId {
-				continue
-			}
-
-			// Get the updated robot record
-			updatedRobot, exists := activeRobotsMap[robotInOldState.Id]
-			if !exists {
-				// Robot is already dead this tick
-				continue
-			}
+	// 3. Update Bullets & Collision Detection (swept, so fast bullets cannot
+	// tunnel through robots that also moved this tick)
+	reach := maxRadius + maxMove
+	ends := make([][2]float32, len(state.Bullets))
+	headings := make([]float32, len(state.Bullets))
+	for i, bullet := range state.Bullets {
+		// Homing projectiles turn before they move
+		headings[i] = pe.steer(bullet, weaponOf(bullet), activeRobotsMap, robotOrder, arenaConfig)
+		ends[i][0] = bullet.Position.X + float32(math.Sin(float64(headings[i]*math.Pi/180.0)))*bullet.Velocity
+		ends[i][1] = bullet.Position.Y - float32(math.Cos(float64(headings[i]*math.Pi/180.0)))*bullet.Velocity
+	}
 
-			// We use old positions for collision detection to be consistent with Quadtree
-			// or we could use updated positions. Given current logic, let's stick to OLD for now
-			// but apply damage to NEW.
-			dx := newX - robotInOldState.Position.X
-			dy := newY - robotInOldState.Position.Y
-			dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	destroyed := map[int]bool{}
+	if arenaConfig.BulletCollisions {
+		impacts := make([]bulletImpact, len(state.Bullets))
+		for i, bullet := range state.Bullets {
+			impacts[i] = pe.sweepBullet(bullet, ends[i][0], ends[i][1], reach, qt, activeRobotsMap, obstacles, arenaConfig)
+		}
+		destroyed = collideBullets(state.Bullets, ends, impacts, newState)
+	}
 
-			if dist < RobotRadius {
-				pe.applyDamage(updatedRobot, bullet.Power, newState)
-				newState.Events = append(newState.Events, &pb.SimulationEvent{
-					Tick: newState.Tick,
-					Event: &pb.SimulationEvent_HitByBullet{
-						HitByBullet: &pb.HitByBulletEvent{
-							VictimId: updatedRobot.Id,
-							BulletId: bullet.Id,
-							Damage:   bullet.Power,
-						},
+	finalBullets := make([]*pb.BulletState, 0)
+	for i, bullet := range state.Bullets {
+		if destroyed[i] {
+			continue
+		}
+		newX, newY := ends[i][0], ends[i][1]
+		damage := weaponOf(bullet).Damage(bullet.Power)
+
+		// Swept again so earlier bullets' kills and destroyed obstacles count
+		impact := pe.sweepBullet(bullet, newX, newY, reach, qt, activeRobotsMap, obstacles, arenaConfig)
+		switch {
+		case impact.robot != nil:
+			updatedRobot := impact.robot
+			pe.applyDamage(updatedRobot, damage, bullet.OwnerId, sources)
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_HitByBullet{
+					HitByBullet: &pb.HitByBulletEvent{
+						VictimId: updatedRobot.Id,
+						BulletId: bullet.Id,
+						Damage:   damage,
 					},
-				})
+				},
+			}, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_BulletHitTarget{
+					BulletHitTarget: &pb.BulletHitTargetEvent{
+						BulletId: bullet.Id,
+						TargetId: updatedRobot.Id,
+						OwnerId:  bullet.OwnerId,
+						Damage:   damage,
+					},
+				},
+			})
+
+			if updatedRobot.Hull <= 0 {
+				pe.processDeath(updatedRobot, sources, newState)
+				delete(activeRobotsMap, updatedRobot.Id)
+			}
 
-				if updatedRobot.Hull <= 0 {
-					pe.processDeath(updatedRobot, newState)
-					delete(activeRobotsMap, updatedRobot.Id)
+		case impact.obstacle != nil:
+			// Obstacles stop bullets; destructible ones absorb the damage
+			if obstacle := impact.obstacle; !obstacle.Indestructible {
+				obstacle.Hp -= damage
+				if obstacle.Hp <= 0 {
+					obstacles = pe.destroyObstacle(obstacle, bullet.OwnerId, obstacles, newState)
 				}
-				hit = true
-				break
 			}
-		}
 
-		if !hit && newX >= 0 && newX <= arenaConfig.Width && newY >= 0 && newY <= arenaConfig.Height {
+		case impact.wall:
+			// Left the arena
+
+		case bullet.Ttl == 1:
+			// Expired
+
+		default:
+			ttl := bullet.Ttl
+			if ttl > 0 {
+				ttl--
+			}
 			finalBullets = append(finalBullets, &pb.BulletState{
 				Id:       bullet.Id,
 				OwnerId:  bullet.OwnerId,
 				Position: &pb.Vector3{X: newX, Y: newY, Z: 0},
-				Heading:  bullet.Heading,
+				Heading:  headings[i],
 				Velocity: bullet.Velocity,
 				Power:    bullet.Power,
+				TeamId:   bullet.TeamId,
+				Weapon:   bullet.Weapon,
+				Ttl:      ttl,
 			})
 		}
 	}
 	newState.Bullets = append(newState.Bullets, finalBullets...)
 
-	// Populate final bots slice and active robots for remaining logic
+	// Populate active robots for remaining logic
 	activeRobots := make([]*pb.BotState, 0, len(activeRobotsMap))
-	for _, b := range activeRobotsMap {
-		newState.Bots = append(newState.Bots, b)
-		activeRobots = append(activeRobots, b)
+	for _, id := range robotOrder {
+		if b, alive := activeRobotsMap[id]; alive {
+			activeRobots = append(activeRobots, b)
+		}
 	}
 
 	// 4. Robot-Robot Collision Resolution via Quadtree
 	for _, r1 := range activeRobots {
-		collisionRange := Rectangle{X: r1.Position.X, Y: r1.Position.Y, W: RobotRadius * 2, H: RobotRadius * 2}
+		r1Radius := pe.Classes.Get(r1.Class).Radius
+		// The quadtree holds last tick's positions, so reach as far as a robot moved
+		collisionReach := r1Radius + reach
+		collisionRange := Rectangle{X: r1.Position.X, Y: r1.Position.Y, W: collisionReach, H: collisionReach}
 		var nearbyBots []*pb.BotState
 		qt.Query(collisionRange, &nearbyBots)
 
-		for _, r2 := range nearbyBots {
-			if r1.Id == r2.Id {
+		for _, candidate := range nearbyBots {
+			if r1.Id == candidate.Id {
+				continue
+			}
+
+			// Push the updated robot; the quadtree holds last tick's input state
+			r2, alive := activeRobotsMap[candidate.Id]
+			if !alive {
 				continue
 			}
 
 			dx := float64(r1.Position.X - r2.Position.X)
 			dy := float64(r1.Position.Y - r2.Position.Y)
 			dist := math.Sqrt(dx*dx + dy*dy)
-			minDist := float64(RobotRadius * 2)
+			r2Radius := pe.Classes.Get(r2.Class).Radius
+			minDist := float64(r1Radius + r2Radius)
 
 			if dist < minDist {
 				overlap := minDist - dist
@@ -219,29 +271,69 @@ func (pe *PhysicsEngine) Update(state *pb.WorldState, arenaConfig *pb.ArenaConfi
 
 				r1.Velocity = 0
 				r2.Velocity = 0
+				resolveObstacleCollision(r1, r1Radius, obstacles, arenaConfig)
+				resolveObstacleCollision(r2, r2Radius, obstacles, arenaConfig)
 
 				damage := float32(0.6)
-				pe.applyDamage(r1, damage, newState)
-				pe.applyDamage(r2, damage, newState)
+				pe.applyDamage(r1, damage, r2.Id, sources)
+				pe.applyDamage(r2, damage, r1.Id, sources)
+
+				// Each pair is resolved once, so tell both robots
+				newState.Events = append(newState.Events, &pb.SimulationEvent{
+					Tick: newState.Tick,
+					Event: &pb.SimulationEvent_HitRobot{
+						HitRobot: &pb.HitRobotEvent{BotId: r1.Id, OtherId: r2.Id},
+					},
+				}, &pb.SimulationEvent{
+					Tick: newState.Tick,
+					Event: &pb.SimulationEvent_HitRobot{
+						HitRobot: &pb.HitRobotEvent{BotId: r2.Id, OtherId: r1.Id},
+					},
+				})
 			}
 		}
+
+	}
+
+	// Collision damage lands on both robots of a pair, so settle deaths afterwards
+	for _, r := range activeRobots {
+		if r.Hull <= 0 {
+			pe.processDeath(r, sources, newState)
+			delete(activeRobotsMap, r.Id)
+		}
 	}
 
+	// Collisions may have destroyed robots, so rebuild the survivor list
+	activeRobots = activeRobots[:0]
+	for _, id := range robotOrder {
+		if b, alive := activeRobotsMap[id]; alive {
+			newState.Bots = append(newState.Bots, b)
+			activeRobots = append(activeRobots, b)
+		}
+	}
+
+	// Survivors collect the pickups they ended the tick on
+	newState.Pickups = pe.updatePickups(state.Pickups, arenaConfig, activeRobots, newState)
+
 	// 5. Radar Scanning Logic via Quadtree
 	for _, scanner := range activeRobots {
-		scannerFOV := float32(TankRadarFOV)
-		if scanner.Class == "Scout" {
-			scannerFOV = ScoutRadarFOV
-		} else if scanner.Class == "Sniper" {
-			scannerFOV = SniperRadarFOV
+		if scanner.RadarJammed {
+			continue
 		}
+		scannerFOV := pe.Classes.Get(scanner.Class).RadarFOV
 
 		radarQueryRange := Rectangle{X: scanner.Position.X, Y: scanner.Position.Y, W: RadarRange, H: RadarRange}
 		var nearbyBots []*pb.BotState
 		qt.Query(radarQueryRange, &nearbyBots)
 
-		for _, target := range nearbyBots {
-			if scanner.Id == target.Id {
+		for _, candidate := range nearbyBots {
+			if scanner.Id == candidate.Id {
+				continue
+			}
+
+			// The quadtree holds last tick's robots; scan their updated state
+			target, alive := activeRobotsMap[candidate.Id]
+			if !alive {
 				continue
 			}
 
@@ -272,16 +364,61 @@ func (pe *PhysicsEngine) Update(state *pb.WorldState, arenaConfig *pb.ArenaConfi
 				diff = 360 - diff
 			}
 
-			if diff <= scannerFOV/2.0 {
-				// Event omitted per current proto limitations in existing code
+			if diff <= scannerFOV/2.0 && !lineOfSightBlocked(scanner.Position, target.Position, obstacles) {
+				newState.Events = append(newState.Events, &pb.SimulationEvent{
+					Tick: newState.Tick,
+					Event: &pb.SimulationEvent_ScannedBot{
+						ScannedBot: &pb.ScannedBotEvent{
+							ScannerId: scanner.Id,
+							TargetId:  target.Id,
+							Distance:  dist,
+							Bearing:   angleToTargetDeg,
+							Heading:   target.Heading,
+							Velocity:  target.Velocity,
+							Energy:    target.Energy,
+						},
+					},
+				})
 			}
 		}
 	}
 
+	newState.Obstacles = obstacles
+
 	return newState
 }
 
-func (pe *PhysicsEngine) applyDamage(robot *pb.BotState, damage float32, state *pb.WorldState) {
+// destroyObstacle removes an obstacle and emits its destruction event.
+func (pe *PhysicsEngine) destroyObstacle(obstacle *pb.ObstacleState, botID string, obstacles []*pb.ObstacleState, newState *pb.WorldState) []*pb.ObstacleState {
+	log.Printf("OBSTACLE: %s destroyed by %s at Tick %d", obstacle.Id, botID, newState.Tick)
+	newState.Events = append(newState.Events, &pb.SimulationEvent{
+		Tick: newState.Tick,
+		Event: &pb.SimulationEvent_ObstacleDestroyed{
+			ObstacleDestroyed: &pb.ObstacleDestroyedEvent{
+				ObstacleId: obstacle.Id,
+				BotId:      botID,
+			},
+		},
+	})
+
+	remaining := make([]*pb.ObstacleState, 0, len(obstacles))
+	for _, o := range obstacles {
+		if o != obstacle {
+			remaining = append(remaining, o)
+		}
+	}
+	return remaining
+}
+
+// damageSources remembers who last damaged each robot during a tick, so deaths
+// can be credited to a bot ID or an environment source.
+type damageSources map[string]string
+
+func (pe *PhysicsEngine) applyDamage(robot *pb.BotState, damage float32, source string, sources damageSources) {
+	if damage > 0 && source != "" {
+		sources[robot.Id] = source
+	}
+
 	if robot.ShieldHp > 0 {
 		if robot.ShieldHp >= damage {
 			robot.ShieldHp -= damage
@@ -297,7 +434,7 @@ func (pe *PhysicsEngine) applyDamage(robot *pb.BotState, damage float32, state *
 	}
 }
 
-func (pe *PhysicsEngine) updateRobot(robot *pb.BotState, arena *pb.ArenaConfig, intent *pb.BotIntent) *pb.BotState {
+func (pe *PhysicsEngine) updateRobot(robot *pb.BotState, arena *pb.ArenaConfig, intent *pb.BotIntent) (*pb.BotState, powerChanges) {
 	newRobot := &pb.BotState{
 		Id:           robot.Id,
 		Name:         robot.Name,
@@ -313,32 +450,17 @@ func (pe *PhysicsEngine) updateRobot(robot *pb.BotState, arena *pb.ArenaConfig,
 		RadarHeading: robot.RadarHeading,
 		Heat:         robot.Heat,
 		IsStealthed:  robot.IsStealthed,
-		Cooldowns:    make(map[string]int32),
-	}
+		ZoneIds:      robot.ZoneIds,
+		RadarJammed:  robot.RadarJammed,
+		Weapons:      robot.Weapons,
 
-	// Copy and Update Cooldowns / Durations
-	for k, v := range robot.Cooldowns {
-		if v > 0 {
-			newRobot.Cooldowns[k] = v - 1
-		}
+		DistanceRemaining: robot.DistanceRemaining,
 	}
 
-	// Constants based on Class
-	maxVel := float32(TankMaxVelocity)
-	accel := float32(TankAccel)
-	decel := float32(TankDecel)
-	maxShield := float32(TankMaxShield)
-	maxEnergy := float32(TankMaxEnergy)
-	regen := float32(0.2) // Default Tank regen
-
-	switch robot.Class {
-	case "Scout":
-		maxVel, accel, decel, maxShield, maxEnergy = ScoutMaxVelocity, ScoutAccel, ScoutDecel, ScoutMaxShield, ScoutMaxEnergy
-		regen = 0.5
-	case "Sniper":
-		maxVel, accel, decel, maxShield, maxEnergy = SniperMaxVelocity, SniperAccel, SniperDecel, SniperMaxShield, SniperMaxEnergy
-		regen = 0.3
-	}
+	// Stats based on Class
+	class := pe.Classes.Get(robot.Class)
+	move := Movement{MaxVelocity: class.MaxVelocity, Accel: class.Accel, Decel: class.Decel}
+	maxEnergy, regen := class.MaxEnergy, class.EnergyRegen
 
 	// Energy Regeneration
 	newRobot.Energy += regen
@@ -349,46 +471,16 @@ func (pe *PhysicsEngine) updateRobot(robot *pb.BotState, arena *pb.ArenaConfig,
 		newRobot.Energy = 0
 	}
 
-	// Handle Special Powers Activation
-	if intent != nil && intent.UsePower != pb.PowerType_POWER_NONE {
-		switch intent.UsePower {
-		case pb.PowerType_SHIELD:
-			if newRobot.Energy >= 30 && newRobot.Cooldowns["shield"] <= 0 {
-				log.Printf("POWER: Bot %s activating SHIELD", newRobot.Id)
-				newRobot.Energy -= 30
-				newRobot.ShieldHp = maxShield
-				newRobot.Cooldowns["shield"] = 200
-			}
-		case pb.PowerType_OVERCLOCK:
-			if newRobot.Energy >= 40 && newRobot.Cooldowns["overclock"] <= 0 {
-				log.Printf("POWER: Bot %s activating OVERCLOCK", newRobot.Id)
-				newRobot.Energy -= 40
-				newRobot.Cooldowns["overclock_duration"] = 100
-				newRobot.Cooldowns["overclock"] = 300
-			}
-		case pb.PowerType_STEALTH:
-			if newRobot.Energy >= 50 && newRobot.Cooldowns["stealth"] <= 0 {
-				log.Printf("POWER: Bot %s activating STEALTH", newRobot.Id)
-				newRobot.Energy -= 50
-				newRobot.Cooldowns["stealth_duration"] = 150
-				newRobot.Cooldowns["stealth"] = 400
-			}
-		}
-	}
+	// Special Powers
+	changes := updatePowers(newRobot, robot.Powers, intent, class)
+	applyActivePowers(newRobot, &move)
 
-	// Apply Passive Power Effects
-	if newRobot.Cooldowns["overclock_duration"] > 0 {
-		maxVel *= 1.5
-		accel *= 2.0
-	}
-	if newRobot.Cooldowns["stealth_duration"] > 0 {
-		newRobot.IsStealthed = true
-	} else {
-		newRobot.IsStealthed = false
-	}
+	// Zones the robot ended last tick in speed it up or slow it down
+	move.MaxVelocity *= zoneSpeedFactor(robot, arena)
+	maxVel, accel, decel := move.MaxVelocity, move.Accel, move.Decel
 
-	// Apply Movement Intent
-	targetVelocity := float32(0.0)
+	// Apply Movement Intent. A new move_distance replaces the old target;
+	// without an intent the robot keeps going until it covers the distance.
 	if intent != nil {
 		newRobot.Heading += intent.TurnDegrees
 		newRobot.GunHeading += intent.GunTurnDegrees
@@ -399,13 +491,13 @@ func (pe *PhysicsEngine) updateRobot(robot *pb.BotState, arena *pb.ArenaConfig,
 		newRobot.GunHeading = pe.normalizeAngle(newRobot.GunHeading)
 		newRobot.RadarHeading = pe.normalizeAngle(newRobot.RadarHeading)
 
-		if intent.MoveDistance > 0 {
-			targetVelocity = maxVel
-		} else if intent.MoveDistance < 0 {
-			targetVelocity = -maxVel
-		}
+		newRobot.DistanceRemaining = intent.MoveDistance
 	}
 
+	// Slow down in time to stop at the end of the distance
+	remaining := float64(newRobot.DistanceRemaining)
+	targetVelocity := float32(math.Copysign(math.Min(float64(maxVel), float64(stoppingSpeed(float32(math.Abs(remaining)), decel))), remaining))
+
 	// Acceleration Physics
 	if newRobot.Velocity < targetVelocity {
 		newRobot.Velocity += accel
@@ -424,30 +516,48 @@ func (pe *PhysicsEngine) updateRobot(robot *pb.BotState, arena *pb.ArenaConfig,
 	newX := newRobot.Position.X + float32(math.Sin(rad))*newRobot.Velocity
 	newY := newRobot.Position.Y - float32(math.Cos(rad))*newRobot.Velocity
 
+	// Count down the distance; a move that overshoots or reverses is done
+	if remaining != 0 {
+		left := remaining - float64(newRobot.Velocity)
+		if left*remaining <= 0 || math.Abs(left) < 0.01 {
+			left = 0
+		}
+		newRobot.DistanceRemaining = float32(left)
+	}
+
 	// Wall Collision
-	margin := float32(RobotRadius)
+	margin := class.Radius
 	if newX <= margin || newX >= float32(arena.Width)-margin || newY <= margin || newY >= float32(arena.Height)-margin {
 		newRobot.Velocity = 0
+		newRobot.DistanceRemaining = 0
 	}
 
 	newRobot.Position.X = float32(math.Max(float64(margin), math.Min(float64(arena.Width)-float64(margin), float64(newX))))
 	newRobot.Position.Y = float32(math.Max(float64(margin), math.Min(float64(arena.Height)-float64(margin), float64(newY))))
 
-	return newRobot
+	return newRobot, changes
 }
 
-func (pe *PhysicsEngine) processDeath(robot *pb.BotState, newState *pb.WorldState) {
-	log.Printf("DEATH: Robot %s destroyed at Tick %d", robot.Id, newState.Tick)
+func (pe *PhysicsEngine) processDeath(robot *pb.BotState, sources damageSources, newState *pb.WorldState) {
+	killerID := sources[robot.Id]
+	log.Printf("DEATH: Robot %s destroyed by %s at Tick %d", robot.Id, killerID, newState.Tick)
 	newState.Events = append(newState.Events, &pb.SimulationEvent{
 		Tick: newState.Tick,
 		Event: &pb.SimulationEvent_Death{
 			Death: &pb.DeathEvent{
-				BotId: robot.Id,
+				BotId:    robot.Id,
+				KillerId: killerID,
 			},
 		},
 	})
 }
 
+// atWall reports whether a robot of the given radius is pressed against the arena boundary.
+func (pe *PhysicsEngine) atWall(robot *pb.BotState, margin float32, arena *pb.ArenaConfig) bool {
+	return robot.Position.X <= margin || robot.Position.X >= arena.Width-margin ||
+		robot.Position.Y <= margin || robot.Position.Y >= arena.Height-margin
+}
+
 func (pe *PhysicsEngine) normalizeAngle(angle float32) float32 {
 	angle = float32(math.Mod(float64(angle), 360.0))
 	if angle < 0 {
@@ -456,7 +566,8 @@ func (pe *PhysicsEngine) normalizeAngle(angle float32) float32 {
 	return angle
 }
 
-// FilterStateForBot creates a customized WorldState for a specific bot based on its sensors
+// FilterStateForBot creates a customized WorldState for a specific bot based on its sensors.
+// Teammates share what their sensors detect and always see each other.
 func (pe *PhysicsEngine) FilterStateForBot(botID string, fullState *pb.WorldState) *pb.WorldState {
 	var viewer *pb.BotState
 	for _, b := range fullState.Bots {
@@ -470,68 +581,46 @@ func (pe *PhysicsEngine) FilterStateForBot(botID string, fullState *pb.WorldStat
 	// Usually they see nothing if they are dead.
 	if viewer == nil {
 		return &pb.WorldState{
-			Tick:   fullState.Tick,
-			Status: fullState.Status,
-			Zone:   fullState.Zone,
+			Tick:      fullState.Tick,
+			Status:    fullState.Status,
+			Zone:      fullState.Zone,
+			Obstacles: fullState.Obstacles,
 		}
 	}
 
 	filteredState := &pb.WorldState{
-		Tick:   fullState.Tick,
-		Status: fullState.Status,
-		Zone:   fullState.Zone,
-		Events: make([]*pb.SimulationEvent, 0),
-		Bots:   make([]*pb.BotState, 0),
+		Tick:      fullState.Tick,
+		Status:    fullState.Status,
+		Zone:      fullState.Zone,
+		Obstacles: fullState.Obstacles, // Map layout is public
+		Events:    make([]*pb.SimulationEvent, 0),
+		Bots:      make([]*pb.BotState, 0),
+		Pickups:   make([]*pb.PickupState, 0),
 	}
 
 	// 1. Bots are always visible to themselves
 	filteredState.Bots = append(filteredState.Bots, viewer)
 
+	// Sensors of the viewer and its teammates
+	observers := []*pb.BotState{viewer}
+	for _, b := range fullState.Bots {
+		if b.Id != botID && sameTeam(b.TeamId, viewer.TeamId) {
+			observers = append(observers, b)
+		}
+	}
+
 	// 2. Filter other bots
 	for _, target := range fullState.Bots {
 		if target.Id == botID {
 			continue
 		}
 
-		dx := target.Position.X - viewer.Position.X
-		dy := target.Position.Y - viewer.Position.Y
-		dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
-
-		// Logic:
-		// - Close range: Always visible (Sensors)
-		// - Radar range: Visible if within FOV or Scanned
-		// - Stealth: Harder to see
-
-		visible := false
-		if dist < 150.0 { // Proximity Sensor
-			visible = true
-		} else if dist < RadarRange {
-			// Basic Radar Logic: Check FOV (Legacy behavior but per-bot now)
-			angleToTarget := math.Atan2(float64(target.Position.X-viewer.Position.X), float64(viewer.Position.Y-target.Position.Y))
-			angleToTargetDeg := float32(angleToTarget * 180.0 / math.Pi)
-			if angleToTargetDeg < 0 {
-				angleToTargetDeg += 360
-			}
-
-			diff := float32(math.Abs(float64(angleToTargetDeg - viewer.RadarHeading)))
-			if diff > 180 {
-				diff = 360 - diff
-			}
-
-			radarFOV := float32(TankRadarFOV)
-			if viewer.Class == "Scout" {
-				radarFOV = ScoutRadarFOV
-			} else if viewer.Class == "Sniper" {
-				radarFOV = SniperRadarFOV
-			}
-
-			if diff <= radarFOV/2.0 {
-				visible = true
+		visible := sameTeam(target.TeamId, viewer.TeamId)
+		for _, observer := range observers {
+			if visible {
+				break
 			}
-		}
-
-		if target.IsStealthed && dist > 100.0 {
-			visible = false // Stealth works unless very close
+			visible = observer.Id != target.Id && pe.canSee(observer, target, fullState.Obstacles)
 		}
 
 		if visible {
@@ -539,7 +628,20 @@ func (pe *PhysicsEngine) FilterStateForBot(botID string, fullState *pb.WorldStat
 		}
 	}
 
-	// 3. Filter Events (only relevant to the bot)
+	// 3. Pickups the team's sensors can see
+	for _, pickup := range fullState.Pickups {
+		if !pickup.Available {
+			continue
+		}
+		for _, observer := range observers {
+			if pe.sensorsDetect(observer, pickup.Position, fullState.Obstacles) {
+				filteredState.Pickups = append(filteredState.Pickups, pickup)
+				break
+			}
+		}
+	}
+
+	// 4. Filter Events (only relevant to the bot)
 	for _, ev := range fullState.Events {
 		relevant := false
 		// Broad relevance: Death of any bot is public?
@@ -548,14 +650,62 @@ func (pe *PhysicsEngine) FilterStateForBot(botID string, fullState *pb.WorldStat
 
 		if death := ev.GetDeath(); death != nil {
 			relevant = true // Deaths are public news
+		} else if ev.GetObstacleDestroyed() != nil {
+			relevant = true // Map changes are visible to everyone
 		} else if hit := ev.GetHitByBullet(); hit != nil {
 			if hit.VictimId == botID {
 				relevant = true
 			}
+		} else if hit := ev.GetBulletHitTarget(); hit != nil {
+			if hit.OwnerId == botID {
+				relevant = true
+			}
+		} else if hit := ev.GetBulletHitBullet(); hit != nil {
+			if hit.OwnerId == botID || hit.OtherOwnerId == botID {
+				relevant = true
+			}
+		} else if wall := ev.GetHitWall(); wall != nil {
+			if wall.BotId == botID {
+				relevant = true
+			}
+		} else if bump := ev.GetHitRobot(); bump != nil {
+			if bump.BotId == botID {
+				relevant = true
+			}
+		} else if scan := ev.GetScannedBot(); scan != nil {
+			// Radar contacts are shared with the team
+			for _, observer := range observers {
+				if scan.ScannerId == observer.Id {
+					relevant = true
+				}
+			}
+		} else if msg := ev.GetTeamMessage(); msg != nil {
+			if msg.SenderId != botID && sameTeam(msg.TeamId, viewer.TeamId) {
+				relevant = true
+			}
 		} else if zone := ev.GetZoneEntered(); zone != nil {
 			if zone.BotId == botID {
 				relevant = true
 			}
+		} else if zone := ev.GetZoneExited(); zone != nil {
+			if zone.BotId == botID {
+				relevant = true
+			}
+		} else if power := ev.GetPowerActivated(); power != nil {
+			// Opponents notice powers used in plain sight
+			relevant = power.BotId == botID || contains(power.AffectedIds, botID) || visibleIn(filteredState.Bots, power.BotId)
+		} else if power := ev.GetPowerExpired(); power != nil {
+			relevant = power.BotId == botID || visibleIn(filteredState.Bots, power.BotId)
+		} else if pickup := ev.GetPickupCollected(); pickup != nil {
+			relevant = pickup.BotId == botID || visibleIn(filteredState.Bots, pickup.BotId)
+		} else if warning := ev.GetIntentWarning(); warning != nil {
+			if warning.BotId == botID {
+				relevant = true
+			}
+		} else if conn := ev.GetBotDisconnected(); conn != nil {
+			relevant = conn.BotId == botID || sameTeam(conn.TeamId, viewer.TeamId)
+		} else if conn := ev.GetBotReconnected(); conn != nil {
+			relevant = conn.BotId == botID || sameTeam(conn.TeamId, viewer.TeamId)
 		}
 
 		if relevant {
@@ -566,6 +716,72 @@ func (pe *PhysicsEngine) FilterStateForBot(botID string, fullState *pb.WorldStat
 	return filteredState
 }
 
+// canSee reports whether an observer's own sensors detect a target.
+func (pe *PhysicsEngine) canSee(observer, target *pb.BotState, obstacles []*pb.ObstacleState) bool {
+	visible := pe.sensorsDetect(observer, target.Position, obstacles)
+
+	dx := target.Position.X - observer.Position.X
+	dy := target.Position.Y - observer.Position.Y
+	dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if target.IsStealthed && dist > 100.0 {
+		visible = false // Stealth works unless very close
+	}
+	return visible
+}
+
+// sensorsDetect reports whether an observer's proximity sensor or radar
+// covers a position.
+func (pe *PhysicsEngine) sensorsDetect(observer *pb.BotState, pos *pb.Vector3, obstacles []*pb.ObstacleState) bool {
+	dx := pos.X - observer.Position.X
+	dy := pos.Y - observer.Position.Y
+	dist := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+
+	// Logic:
+	// - Close range: Always visible (Sensors)
+	// - Radar range: Visible if within FOV or Scanned
+
+	visible := false
+	if dist < 150.0 { // Proximity Sensor
+		visible = true
+	} else if dist < RadarRange && !observer.RadarJammed {
+		// Basic Radar Logic: Check FOV (Legacy behavior but per-bot now)
+		angleToTarget := math.Atan2(float64(pos.X-observer.Position.X), float64(observer.Position.Y-pos.Y))
+		angleToTargetDeg := float32(angleToTarget * 180.0 / math.Pi)
+		if angleToTargetDeg < 0 {
+			angleToTargetDeg += 360
+		}
+
+		diff := float32(math.Abs(float64(angleToTargetDeg - observer.RadarHeading)))
+		if diff > 180 {
+			diff = 360 - diff
+		}
+
+		radarFOV := pe.Classes.Get(observer.Class).RadarFOV
+
+		// Radar needs a clear line of sight; the proximity sensor does not
+		if diff <= radarFOV/2.0 && !lineOfSightBlocked(observer.Position, pos, obstacles) {
+			visible = true
+		}
+	}
+	return visible
+}
+
+// visibleIn reports whether a bot is among those a viewer can see.
+func visibleIn(bots []*pb.BotState, id string) bool {
+	for _, b := range bots {
+		if b.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sameTeam reports whether two team IDs put bots on the same side. Bots
+// without a team are on their own.
+func sameTeam(a, b string) bool {
+	return a != "" && a == b
+}
+
 // --- Quadtree Implementation for Spatial Partitioning ---
 
 type Rectangle struct {
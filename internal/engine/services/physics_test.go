@@ -1,6 +1,7 @@
 package services
 
 import (
+	"math"
 	"testing"
 
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
@@ -19,7 +20,7 @@ func TestUpdateRobot_Movement(t *testing.T) {
 
 	// 1. Accelerate
 	intent := &pb.BotIntent{MoveDistance: 100}
-	updated := pe.updateRobot(robot, arena, intent)
+	updated, _ := pe.updateRobot(robot, arena, intent)
 
 	if updated.Velocity <= 0 {
 		t.Errorf("Expected velocity to increase, got %f", updated.Velocity)
@@ -45,7 +46,7 @@ func TestUpdateRobot_BoundaryCollision(t *testing.T) {
 	}
 
 	intent := &pb.BotIntent{MoveDistance: 100}
-	updated := pe.updateRobot(robot, arena, intent)
+	updated, _ := pe.updateRobot(robot, arena, intent)
 
 	if updated.Position.X < RobotRadius {
 		t.Errorf("Bot moved out of west boundary: %f", updated.Position.X)
@@ -96,3 +97,324 @@ func TestPhysicsUpdate_RobotCollision(t *testing.T) {
 		t.Errorf("Bots should have taken collision damage: b1.Hull=%f, b2.Hull=%f", b1.Hull, b2.Hull)
 	}
 }
+
+func TestPhysicsUpdate_FastRobotsCollide(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	// Closing at 12 per tick, they end 36 apart, inside the 40 radius sum,
+	// though 60 apart in the positions the broad phase was built from
+	state := &pb.WorldState{
+		Tick: 1,
+		Bots: []*pb.BotState{
+			{Id: "bot1", Class: ClassScout, Position: &pb.Vector3{X: 100, Y: 300}, Heading: 90, Velocity: 12, Hull: 100},
+			{Id: "bot2", Class: ClassScout, Position: &pb.Vector3{X: 160, Y: 300}, Heading: 270, Velocity: 12, Hull: 100},
+		},
+	}
+	intents := map[string]*pb.BotIntent{"bot1": {MoveDistance: 100}, "bot2": {MoveDistance: 100}}
+
+	newState := pe.Update(state, arena, intents)
+
+	hits := 0
+	for _, ev := range newState.Events {
+		if ev.GetHitRobot() != nil {
+			hits++
+		}
+	}
+	if hits != 2 {
+		t.Errorf("Expected the robots to collide, got %d hit events", hits)
+	}
+	for _, b := range newState.Bots {
+		if b.Hull >= 100 {
+			t.Errorf("Expected %s to take ram damage, hull=%f", b.Id, b.Hull)
+		}
+	}
+}
+
+func TestPhysicsUpdate_ObstacleBlocksRobot(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", Position: &pb.Vector3{X: 400, Y: 250}, Heading: 180, Velocity: 6, Hull: 100},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock", Position: &pb.Vector3{X: 400, Y: 300}, Radius: 30, Indestructible: true},
+		},
+	}
+
+	newState := pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": {MoveDistance: 100}})
+
+	bot := newState.Bots[0]
+	dy := float64(300 - bot.Position.Y)
+	if dy < 30+RobotRadius-0.01 {
+		t.Errorf("Bot should have been pushed out of the obstacle, distance=%f", dy)
+	}
+	if bot.Velocity != 0 {
+		t.Errorf("Expected bot to stop on obstacle, velocity=%f", bot.Velocity)
+	}
+}
+
+func TestPhysicsUpdate_CollisionPushKeepsRobotsOutOfObstacles(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	// bot2 sits against the rock; bot1 overlapping it pushes bot2 west
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", Position: &pb.Vector3{X: 420, Y: 300}, Hull: 100},
+			{Id: "bot2", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock", Position: &pb.Vector3{X: 350, Y: 300}, Radius: 30, Indestructible: true},
+		},
+	}
+
+	newState := pe.Update(state, arena, make(map[string]*pb.BotIntent))
+
+	for _, b := range newState.Bots {
+		dx := float64(b.Position.X - 350)
+		dy := float64(b.Position.Y - 300)
+		if dist := math.Sqrt(dx*dx + dy*dy); dist < 30+RobotRadius-0.01 {
+			t.Errorf("Expected %s outside the obstacle, distance=%f", b.Id, dist)
+		}
+	}
+}
+
+func TestPhysicsUpdate_ObstacleAgainstWall(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	// The rock pushes west, straight into the wall
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", Position: &pb.Vector3{X: 30, Y: 300}, Hull: 100},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock", Position: &pb.Vector3{X: 60, Y: 300}, Radius: 30, Indestructible: true},
+		},
+	}
+
+	newState := pe.Update(state, arena, make(map[string]*pb.BotIntent))
+
+	bot := newState.Bots[0]
+	if bot.Position.X < RobotRadius {
+		t.Errorf("Expected bot to stay inside the arena, got X=%f", bot.Position.X)
+	}
+}
+
+func TestPhysicsUpdate_SqueezedBetweenObstacles(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	// Leaving the first rock lands the bot in the second
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", Position: &pb.Vector3{X: 430, Y: 310}, Hull: 100},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock1", Position: &pb.Vector3{X: 400, Y: 300}, Radius: 30, Indestructible: true},
+			{Id: "rock2", Position: &pb.Vector3{X: 460, Y: 330}, Radius: 30, Indestructible: true},
+		},
+	}
+
+	newState := pe.Update(state, arena, make(map[string]*pb.BotIntent))
+
+	bot := newState.Bots[0]
+	for _, o := range state.Obstacles {
+		dx := float64(bot.Position.X - o.Position.X)
+		dy := float64(bot.Position.Y - o.Position.Y)
+		if dist := math.Sqrt(dx*dx + dy*dy); dist < 30+RobotRadius-0.01 {
+			t.Errorf("Expected bot outside %s, distance=%f", o.Id, dist)
+		}
+	}
+}
+
+func TestPhysicsUpdate_BulletDestroysObstacle(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	state := &pb.WorldState{
+		Bullets: []*pb.BulletState{
+			{Id: "b1", OwnerId: "bot1", Position: &pb.Vector3{X: 400, Y: 250}, Heading: 180, Velocity: 20, Power: 3},
+			{Id: "b2", OwnerId: "bot1", Position: &pb.Vector3{X: 100, Y: 250}, Heading: 180, Velocity: 20, Power: 3},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "crate", Position: &pb.Vector3{X: 400, Y: 280}, Radius: 15, Hp: 2},
+			{Id: "wall", Position: &pb.Vector3{X: 100, Y: 280}, Radius: 15, Indestructible: true},
+		},
+	}
+
+	newState := pe.Update(state, arena, nil)
+
+	if len(newState.Bullets) != 0 {
+		t.Errorf("Bullets should stop on obstacles, got %d", len(newState.Bullets))
+	}
+	if len(newState.Obstacles) != 1 || newState.Obstacles[0].Id != "wall" {
+		t.Fatalf("Expected only the indestructible obstacle to remain, got %v", newState.Obstacles)
+	}
+	if state.Obstacles[0].Hp != 2 {
+		t.Errorf("Previous state should not be mutated, hp=%f", state.Obstacles[0].Hp)
+	}
+
+	destroyed := false
+	for _, ev := range newState.Events {
+		if d := ev.GetObstacleDestroyed(); d != nil && d.ObstacleId == "crate" && d.BotId == "bot1" {
+			destroyed = true
+		}
+	}
+	if !destroyed {
+		t.Error("Expected ObstacleDestroyed event for crate")
+	}
+}
+
+func TestFilterStateForBot_ObstacleBlocksRadar(t *testing.T) {
+	pe := NewPhysicsEngine()
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "viewer", Position: &pb.Vector3{X: 400, Y: 500}, RadarHeading: 0},
+			{Id: "target", Position: &pb.Vector3{X: 400, Y: 200}},
+		},
+		Obstacles: []*pb.ObstacleState{
+			{Id: "rock", Position: &pb.Vector3{X: 400, Y: 350}, Radius: 30, Indestructible: true},
+		},
+	}
+
+	if filtered := pe.FilterStateForBot("viewer", state); len(filtered.Bots) != 1 {
+		t.Errorf("Target behind obstacle should be hidden, saw %d bots", len(filtered.Bots))
+	}
+
+	state.Obstacles = nil
+	if filtered := pe.FilterStateForBot("viewer", state); len(filtered.Bots) != 2 {
+		t.Errorf("Target in radar FOV should be visible, saw %d bots", len(filtered.Bots))
+	}
+}
+
+func TestPhysicsUpdate_BumpEvents(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", Position: &pb.Vector3{X: 25, Y: 300}, Heading: 270, Velocity: 5, Hull: 100},
+			{Id: "bot2", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100},
+			{Id: "bot3", Position: &pb.Vector3{X: 420, Y: 300}, Hull: 100},
+		},
+	}
+
+	newState := pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": {MoveDistance: 100}})
+
+	walls := map[string]bool{}
+	bumps := map[string]string{}
+	for _, ev := range newState.Events {
+		if w := ev.GetHitWall(); w != nil {
+			walls[w.BotId] = true
+		}
+		if r := ev.GetHitRobot(); r != nil {
+			bumps[r.BotId] = r.OtherId
+		}
+	}
+
+	if !walls["bot1"] || len(walls) != 1 {
+		t.Errorf("Expected only bot1 to hit a wall, got %v", walls)
+	}
+	if bumps["bot2"] != "bot3" || bumps["bot3"] != "bot2" {
+		t.Errorf("Expected HitRobot events for both bots, got %v", bumps)
+	}
+}
+
+func TestPhysicsUpdate_RadarScan(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "scanner", Position: &pb.Vector3{X: 400, Y: 500}, RadarHeading: 0, Hull: 100},
+			{Id: "target", Position: &pb.Vector3{X: 400, Y: 200}, Heading: 90, Energy: 42, Hull: 100},
+			{Id: "behind", Position: &pb.Vector3{X: 400, Y: 580}, Hull: 100},
+		},
+	}
+
+	newState := pe.Update(state, arena, nil)
+
+	var scans []*pb.ScannedBotEvent
+	for _, ev := range newState.Events {
+		if s := ev.GetScannedBot(); s != nil && s.ScannerId == "scanner" {
+			scans = append(scans, s)
+		}
+	}
+	if len(scans) != 1 {
+		t.Fatalf("Expected exactly one scan from scanner, got %d", len(scans))
+	}
+	if scans[0].TargetId != "target" || scans[0].Bearing != 0 || scans[0].Heading != 90 {
+		t.Errorf("Unexpected scan: %v", scans[0])
+	}
+	if scans[0].Distance < 299 || scans[0].Distance > 301 {
+		t.Errorf("Expected distance ~300, got %f", scans[0].Distance)
+	}
+
+	for _, ev := range pe.FilterStateForBot("target", newState).Events {
+		if s := ev.GetScannedBot(); s != nil && s.ScannerId != "target" {
+			t.Errorf("Scan by %s leaked to target", s.ScannerId)
+		}
+	}
+}
+
+func TestPhysicsUpdate_FriendlyFire(t *testing.T) {
+	pe := NewPhysicsEngine()
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", TeamId: "red", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100},
+			{Id: "bot2", TeamId: "red", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100},
+		},
+		Bullets: []*pb.BulletState{
+			{Id: "b1", OwnerId: "bot1", TeamId: "red", Position: &pb.Vector3{X: 400, Y: 300}, Velocity: 0, Power: 3},
+		},
+	}
+
+	off := pe.Update(state, &pb.ArenaConfig{Width: 800, Height: 600}, nil)
+	for _, b := range off.Bots {
+		if b.Id == "bot2" && b.Hull != 100 {
+			t.Errorf("Teammate should not be damaged without friendly fire, hull=%f", b.Hull)
+		}
+	}
+
+	on := pe.Update(state, &pb.ArenaConfig{Width: 800, Height: 600, FriendlyFire: true}, nil)
+	for _, b := range on.Bots {
+		if b.Id == "bot2" && b.Hull >= 100 {
+			t.Errorf("Teammate should be damaged with friendly fire, hull=%f", b.Hull)
+		}
+	}
+}
+
+func TestFilterStateForBot_TeamSharedVision(t *testing.T) {
+	pe := NewPhysicsEngine()
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			// Viewer looks away from everything
+			{Id: "viewer", TeamId: "red", Position: &pb.Vector3{X: 100, Y: 500}, RadarHeading: 180},
+			{Id: "spotter", TeamId: "red", Position: &pb.Vector3{X: 700, Y: 500}, RadarHeading: 0},
+			{Id: "enemy", TeamId: "blue", Position: &pb.Vector3{X: 700, Y: 200}},
+		},
+		Events: []*pb.SimulationEvent{
+			{Event: &pb.SimulationEvent_ScannedBot{ScannedBot: &pb.ScannedBotEvent{ScannerId: "spotter", TargetId: "enemy"}}},
+			{Event: &pb.SimulationEvent_TeamMessage{TeamMessage: &pb.TeamMessageEvent{SenderId: "spotter", TeamId: "red", Message: "enemy north"}}},
+		},
+	}
+
+	filtered := pe.FilterStateForBot("viewer", state)
+	if len(filtered.Bots) != 3 {
+		t.Errorf("Expected viewer to see teammate and the enemy it spotted, saw %d bots", len(filtered.Bots))
+	}
+	if len(filtered.Events) != 2 {
+		t.Errorf("Expected shared scan and team message, got %d events", len(filtered.Events))
+	}
+
+	enemyView := pe.FilterStateForBot("enemy", state)
+	for _, ev := range enemyView.Events {
+		if ev.GetTeamMessage() != nil {
+			t.Error("Team message leaked to the other team")
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"math"
+	"sync"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Built-in pickup types
+const (
+	PickupHealth = "HEALTH"
+	PickupEnergy = "ENERGY"
+	PickupAmmo   = "AMMO"
+)
+
+// PickupEffect describes what collecting a pickup type does. The amount comes
+// from the spawn point, or Amount when the spawn sets none.
+type PickupEffect struct {
+	Amount   float32
+	Heals    bool // Restores hull, up to the class maximum
+	Charges  bool // Restores energy, up to the class maximum
+	CoolsGun bool // Removes gun heat
+}
+
+var (
+	pickupTypesMu sync.RWMutex
+	pickupTypes   = map[string]PickupEffect{
+		PickupHealth: {Amount: HealthPickupAmount, Heals: true},
+		PickupEnergy: {Amount: EnergyPickupAmount, Charges: true},
+		PickupAmmo:   {Amount: AmmoPickupAmount, CoolsGun: true},
+	}
+)
+
+// RegisterPickupType adds or replaces a pickup type.
+func RegisterPickupType(pickupType string, effect PickupEffect) {
+	pickupTypesMu.Lock()
+	defer pickupTypesMu.Unlock()
+	pickupTypes[pickupType] = effect
+}
+
+// LookupPickupType returns the effect of a pickup type.
+func LookupPickupType(pickupType string) (PickupEffect, bool) {
+	pickupTypesMu.RLock()
+	defer pickupTypesMu.RUnlock()
+	effect, ok := pickupTypes[pickupType]
+	return effect, ok
+}
+
+// NewPickupStates builds the opening pickups of an arena, all available.
+func NewPickupStates(spawns []*pb.PickupSpawn) []*pb.PickupState {
+	states := make([]*pb.PickupState, 0, len(spawns))
+	for _, s := range spawns {
+		states = append(states, &pb.PickupState{
+			Id:        s.Id,
+			Type:      s.Type,
+			Position:  &pb.Vector3{X: s.Position.X, Y: s.Position.Y, Z: 0},
+			Available: true,
+		})
+	}
+	return states
+}
+
+// updatePickups counts down respawn timers, then lets robots collect the
+// pickups they touch. Robots are visited in order, so the first one in the
+// list wins a contested pickup. The previous pickup states are not modified.
+func (pe *PhysicsEngine) updatePickups(pickups []*pb.PickupState, arena *pb.ArenaConfig, robots []*pb.BotState, newState *pb.WorldState) []*pb.PickupState {
+	if len(pickups) == 0 {
+		return nil
+	}
+	spawns := make(map[string]*pb.PickupSpawn, len(arena.Pickups))
+	for _, s := range arena.Pickups {
+		spawns[s.Id] = s
+	}
+
+	next := make([]*pb.PickupState, 0, len(pickups))
+	for _, p := range pickups {
+		p = proto.Clone(p).(*pb.PickupState)
+		next = append(next, p)
+		if !p.Available {
+			if p.RespawnIn > 0 {
+				p.RespawnIn--
+				p.Available = p.RespawnIn == 0
+			}
+			continue
+		}
+
+		for _, robot := range robots {
+			dx := float64(robot.Position.X - p.Position.X)
+			dy := float64(robot.Position.Y - p.Position.Y)
+			if math.Sqrt(dx*dx+dy*dy) > float64(pe.Classes.Get(robot.Class).Radius+PickupRadius) {
+				continue
+			}
+
+			spawn := spawns[p.Id]
+			amount := pe.collectPickup(robot, p.Type, spawn.GetAmount())
+			p.Available = false
+			p.RespawnIn = spawn.GetRespawnTicks()
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_PickupCollected{
+					PickupCollected: &pb.PickupCollectedEvent{
+						BotId:    robot.Id,
+						PickupId: p.Id,
+						Type:     p.Type,
+						Amount:   amount,
+					},
+				},
+			})
+			break
+		}
+	}
+	return next
+}
+
+// collectPickup applies a pickup to a robot and returns how much it actually
+// restored.
+func (pe *PhysicsEngine) collectPickup(robot *pb.BotState, pickupType string, amount float32) float32 {
+	effect, _ := LookupPickupType(pickupType)
+	if amount <= 0 {
+		amount = effect.Amount
+	}
+	class := pe.Classes.Get(robot.Class)
+
+	var applied float32
+	switch {
+	case effect.Heals:
+		applied = float32(math.Min(float64(amount), math.Max(0, float64(class.MaxHull-robot.Hull))))
+		robot.Hull += applied
+	case effect.Charges:
+		applied = float32(math.Min(float64(amount), math.Max(0, float64(class.MaxEnergy-robot.Energy))))
+		robot.Energy += applied
+	case effect.CoolsGun:
+		applied = float32(math.Min(float64(amount), float64(robot.Heat)))
+		robot.Heat -= applied
+	}
+	return applied
+}
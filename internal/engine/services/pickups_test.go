@@ -0,0 +1,79 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestPhysicsUpdate_PickupCollectAndRespawn(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600, Pickups: []*pb.PickupSpawn{
+		{Id: "medkit", Position: &pb.Vector3{X: 200, Y: 300}, Type: PickupHealth, Amount: 20, RespawnTicks: 3},
+	}}
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot1", Position: &pb.Vector3{X: 205, Y: 300}, Hull: 50},
+			{Id: "bot2", Position: &pb.Vector3{X: 195, Y: 300}, Hull: 50},
+		},
+		Pickups: NewPickupStates(arena.Pickups),
+	}
+	initial := state.Pickups[0]
+
+	state = pe.Update(state, arena, nil)
+
+	var collected []*pb.PickupCollectedEvent
+	for _, ev := range state.Events {
+		if c := ev.GetPickupCollected(); c != nil {
+			collected = append(collected, c)
+		}
+	}
+	if len(collected) != 1 || collected[0].BotId != "bot1" || collected[0].Amount != 20 {
+		t.Fatalf("Expected bot1 to collect 20 hull, got %v", collected)
+	}
+	if !initial.Available {
+		t.Error("Previous state should not be mutated")
+	}
+	if p := state.Pickups[0]; p.Available || p.RespawnIn != 3 {
+		t.Errorf("Expected the pickup to wait 3 ticks, got %v", p)
+	}
+
+	// Move the bots away and wait for the respawn
+	for _, b := range state.Bots {
+		b.Position.Y = 100
+	}
+	for i := 0; i < 3; i++ {
+		state = pe.Update(state, arena, nil)
+	}
+	if !state.Pickups[0].Available {
+		t.Errorf("Expected the pickup to respawn, got %v", state.Pickups[0])
+	}
+}
+
+func TestFilterStateForBot_PickupVisibility(t *testing.T) {
+	pe := NewPhysicsEngine()
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "viewer", Position: &pb.Vector3{X: 400, Y: 500}, RadarHeading: 0},
+		},
+		Pickups: []*pb.PickupState{
+			{Id: "near", Position: &pb.Vector3{X: 450, Y: 500}, Available: true},
+			{Id: "ahead", Position: &pb.Vector3{X: 400, Y: 100}, Available: true},
+			{Id: "behind", Position: &pb.Vector3{X: 400, Y: 1000}, Available: true},
+			{Id: "taken", Position: &pb.Vector3{X: 420, Y: 500}},
+		},
+	}
+
+	filtered := pe.FilterStateForBot("viewer", state)
+
+	seen := map[string]bool{}
+	for _, p := range filtered.Pickups {
+		seen[p.Id] = true
+	}
+	if !seen["near"] || !seen["ahead"] || seen["behind"] || seen["taken"] {
+		t.Errorf("Expected only near and ahead to be visible, got %v", seen)
+	}
+	if dead := pe.FilterStateForBot("ghost", state); len(dead.Pickups) != 0 {
+		t.Errorf("Dead bots should not see pickups, got %v", dead.Pickups)
+	}
+}
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"math"
+	"sync"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// Power is a special ability bots trigger with BotIntent.use_power. Powers are
+// looked up by type, so new ones only need to be registered.
+type Power interface {
+	Cost() float32   // Energy spent on activation
+	Cooldown() int32 // Ticks before the power can be used again
+	Duration() int32 // Ticks the effect lasts; 0 for instant powers
+
+	// Activate applies the power's immediate effect to the bot using it.
+	Activate(robot *pb.BotState, class *ClassDef)
+	// Apply runs every tick the power is active, starting with the tick it
+	// was activated, and may change how the bot moves.
+	Apply(robot *pb.BotState, move *Movement)
+	// Expire undoes lasting effects once the duration runs out.
+	Expire(robot *pb.BotState)
+}
+
+// AreaPower is implemented by powers that also reach other bots when
+// activated. Teammates are never affected.
+type AreaPower interface {
+	Power
+	// AffectNearby applies the power to another bot and reports whether it
+	// was in reach.
+	AffectNearby(user, target *pb.BotState) bool
+}
+
+// Movement holds the movement stats of a bot for the current tick.
+type Movement struct {
+	MaxVelocity float32
+	Accel       float32
+	Decel       float32
+}
+
+// BasePower implements Power without any effect. Powers embed it and override
+// the hooks they need.
+type BasePower struct {
+	EnergyCost    float32
+	CooldownTicks int32
+	DurationTicks int32
+}
+
+func (p BasePower) Cost() float32                  { return p.EnergyCost }
+func (p BasePower) Cooldown() int32                { return p.CooldownTicks }
+func (p BasePower) Duration() int32                { return p.DurationTicks }
+func (BasePower) Activate(*pb.BotState, *ClassDef) {}
+func (BasePower) Apply(*pb.BotState, *Movement)    {}
+func (BasePower) Expire(*pb.BotState)              {}
+
+// ShieldPower charges the shield to the class maximum.
+type ShieldPower struct{ BasePower }
+
+func (ShieldPower) Activate(robot *pb.BotState, class *ClassDef) {
+	robot.ShieldHp = class.MaxShield
+}
+
+// OverclockPower raises top speed and acceleration while active.
+type OverclockPower struct {
+	BasePower
+	SpeedFactor float32
+	AccelFactor float32
+}
+
+func (p OverclockPower) Apply(_ *pb.BotState, move *Movement) {
+	move.MaxVelocity *= p.SpeedFactor
+	move.Accel *= p.AccelFactor
+}
+
+// StealthPower hides the bot from radar while active.
+type StealthPower struct{ BasePower }
+
+func (StealthPower) Apply(robot *pb.BotState, _ *Movement) { robot.IsStealthed = true }
+func (StealthPower) Expire(robot *pb.BotState)             { robot.IsStealthed = false }
+
+// RepairPower restores hull, up to the class maximum.
+type RepairPower struct {
+	BasePower
+	Amount float32
+}
+
+func (p RepairPower) Activate(robot *pb.BotState, class *ClassDef) {
+	robot.Hull = float32(math.Min(float64(class.MaxHull), float64(robot.Hull+p.Amount)))
+}
+
+// EMPPower drains the energy of bots within range.
+type EMPPower struct {
+	BasePower
+	Range float32
+	Drain float32
+}
+
+func (p EMPPower) AffectNearby(user, target *pb.BotState) bool {
+	dx := float64(target.Position.X - user.Position.X)
+	dy := float64(target.Position.Y - user.Position.Y)
+	if math.Sqrt(dx*dx+dy*dy) > float64(p.Range) {
+		return false
+	}
+	target.Energy = float32(math.Max(0, float64(target.Energy-p.Drain)))
+	return true
+}
+
+var (
+	powersMu sync.RWMutex
+	powers   = map[pb.PowerType]Power{
+		pb.PowerType_SHIELD:    ShieldPower{BasePower{EnergyCost: 30, CooldownTicks: 200}},
+		pb.PowerType_OVERCLOCK: OverclockPower{BasePower{EnergyCost: 40, CooldownTicks: 300, DurationTicks: 100}, 1.5, 2},
+		pb.PowerType_STEALTH:   StealthPower{BasePower{EnergyCost: 50, CooldownTicks: 400, DurationTicks: 150}},
+		pb.PowerType_REPAIR:    RepairPower{BasePower{EnergyCost: 40, CooldownTicks: 300}, 25},
+		pb.PowerType_EMP:       EMPPower{BasePower{EnergyCost: 60, CooldownTicks: 400}, 200, 50},
+		pb.PowerType_DASH:      OverclockPower{BasePower{EnergyCost: 20, CooldownTicks: 120, DurationTicks: 10}, 2, 4},
+	}
+)
+
+// RegisterPower adds or replaces a power.
+func RegisterPower(power pb.PowerType, p Power) {
+	powersMu.Lock()
+	defer powersMu.Unlock()
+	powers[power] = p
+}
+
+// LookupPower returns the power registered for a type.
+func LookupPower(power pb.PowerType) (Power, bool) {
+	powersMu.RLock()
+	defer powersMu.RUnlock()
+	p, ok := powers[power]
+	return p, ok
+}
+
+// powerChanges records the powers a bot activated and lost this tick.
+type powerChanges struct {
+	activated pb.PowerType
+	expired   []pb.PowerType
+}
+
+// updatePowers counts down the bot's power cooldowns and effects, expiring
+// those that run out, then activates the power the intent asks for if it is
+// ready and affordable.
+func updatePowers(robot *pb.BotState, previous []*pb.PowerState, intent *pb.BotIntent, class *ClassDef) powerChanges {
+	var changes powerChanges
+	robot.Powers = nil
+	for _, p := range previous {
+		next := &pb.PowerState{Type: p.Type}
+		if p.Cooldown > 0 {
+			next.Cooldown = p.Cooldown - 1
+		}
+		if p.ActiveTicks > 0 {
+			next.ActiveTicks = p.ActiveTicks - 1
+			if next.ActiveTicks == 0 {
+				if power, ok := LookupPower(p.Type); ok {
+					power.Expire(robot)
+				}
+				changes.expired = append(changes.expired, p.Type)
+			}
+		}
+		if next.Cooldown > 0 || next.ActiveTicks > 0 {
+			robot.Powers = append(robot.Powers, next)
+		}
+	}
+
+	if intent == nil || intent.UsePower == pb.PowerType_POWER_NONE {
+		return changes
+	}
+	power, ok := LookupPower(intent.UsePower)
+	if !ok || robot.Energy < power.Cost() {
+		return changes
+	}
+	for _, p := range robot.Powers {
+		if p.Type == intent.UsePower && p.Cooldown > 0 {
+			return changes
+		}
+	}
+
+	robot.Energy -= power.Cost()
+	power.Activate(robot, class)
+	robot.Powers = append(powerStatesWithout(robot.Powers, intent.UsePower), &pb.PowerState{
+		Type:        intent.UsePower,
+		Cooldown:    power.Cooldown(),
+		ActiveTicks: power.Duration(),
+	})
+	changes.activated = intent.UsePower
+	return changes
+}
+
+// applyActivePowers lets every active power adjust the bot and its movement.
+func applyActivePowers(robot *pb.BotState, move *Movement) {
+	for _, p := range robot.Powers {
+		if p.ActiveTicks <= 0 {
+			continue
+		}
+		if power, ok := LookupPower(p.Type); ok {
+			power.Apply(robot, move)
+		}
+	}
+}
+
+// emitPowerActivation emits the activation of a power, applying area powers to
+// every other live robot first.
+func emitPowerActivation(user *pb.BotState, powerType pb.PowerType, robots map[string]*pb.BotState, order []string, newState *pb.WorldState) {
+	power, _ := LookupPower(powerType)
+	event := &pb.PowerActivatedEvent{
+		BotId:    user.Id,
+		Power:    powerType,
+		Duration: power.Duration(),
+	}
+	if area, ok := power.(AreaPower); ok {
+		for _, id := range order {
+			target, alive := robots[id]
+			if !alive || id == user.Id || sameTeam(user.TeamId, target.TeamId) {
+				continue
+			}
+			if area.AffectNearby(user, target) {
+				event.AffectedIds = append(event.AffectedIds, id)
+			}
+		}
+	}
+	newState.Events = append(newState.Events, &pb.SimulationEvent{
+		Tick:  newState.Tick,
+		Event: &pb.SimulationEvent_PowerActivated{PowerActivated: event},
+	})
+}
+
+func powerStatesWithout(states []*pb.PowerState, powerType pb.PowerType) []*pb.PowerState {
+	kept := states[:0]
+	for _, p := range states {
+		if p.Type != powerType {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func powerEvents(events []*pb.SimulationEvent) (activated []*pb.PowerActivatedEvent, expired []*pb.PowerExpiredEvent) {
+	for _, ev := range events {
+		if a := ev.GetPowerActivated(); a != nil {
+			activated = append(activated, a)
+		}
+		if x := ev.GetPowerExpired(); x != nil {
+			expired = append(expired, x)
+		}
+	}
+	return activated, expired
+}
+
+func TestPhysicsUpdate_ShieldActivationIsVisible(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "bot1", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100, Energy: 100},
+		{Id: "bot2", Position: &pb.Vector3{X: 450, Y: 300}, Hull: 100, Energy: 100},
+	}}
+	use := map[string]*pb.BotIntent{"bot1": {UsePower: pb.PowerType_SHIELD}}
+
+	state = pe.Update(state, arena, use)
+
+	shield, _ := LookupPower(pb.PowerType_SHIELD)
+	bot := state.Bots[0]
+	if bot.ShieldHp != pe.Classes.Get("").MaxShield {
+		t.Errorf("Expected a full shield, got %f", bot.ShieldHp)
+	}
+	if len(bot.Powers) != 1 || bot.Powers[0].Cooldown != shield.Cooldown() {
+		t.Errorf("Expected the shield to cool down, got %v", bot.Powers)
+	}
+	if activated, _ := powerEvents(pe.FilterStateForBot("bot2", state).Events); len(activated) != 1 || activated[0].BotId != "bot1" {
+		t.Errorf("Expected the opponent to see the shield go up, got %v", activated)
+	}
+
+	// Still cooling down
+	energy := state.Bots[0].Energy
+	state = pe.Update(state, arena, use)
+	if activated, _ := powerEvents(state.Events); len(activated) != 0 {
+		t.Errorf("Shield should not activate during its cooldown, got %v", activated)
+	}
+	if state.Bots[0].Energy < energy {
+		t.Errorf("A refused activation should cost nothing, energy %f -> %f", energy, state.Bots[0].Energy)
+	}
+}
+
+func TestPhysicsUpdate_StealthExpires(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	stealth, _ := LookupPower(pb.PowerType_STEALTH)
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "bot1", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100, Energy: 100},
+	}}
+
+	state = pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": {UsePower: pb.PowerType_STEALTH}})
+	if !state.Bots[0].IsStealthed {
+		t.Fatal("Expected the bot to be stealthed")
+	}
+
+	var expired []*pb.PowerExpiredEvent
+	for i := int32(0); i < stealth.Duration(); i++ {
+		state = pe.Update(state, arena, nil)
+		_, x := powerEvents(state.Events)
+		expired = append(expired, x...)
+	}
+
+	if state.Bots[0].IsStealthed {
+		t.Error("Stealth should wear off after its duration")
+	}
+	if len(expired) != 1 || expired[0].Power != pb.PowerType_STEALTH {
+		t.Errorf("Expected one expiry event, got %v", expired)
+	}
+}
+
+func TestPhysicsUpdate_EMPDrainsNearbyEnemies(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "bot1", TeamId: "red", Position: &pb.Vector3{X: 300, Y: 300}, Hull: 100, Energy: 100},
+		{Id: "enemy", TeamId: "blue", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100, Energy: 100},
+		{Id: "far", TeamId: "blue", Position: &pb.Vector3{X: 700, Y: 300}, Hull: 100, Energy: 100},
+		{Id: "mate", TeamId: "red", Position: &pb.Vector3{X: 300, Y: 400}, Hull: 100, Energy: 100},
+	}}
+
+	state = pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": {UsePower: pb.PowerType_EMP}})
+
+	activated, _ := powerEvents(state.Events)
+	if len(activated) != 1 || len(activated[0].AffectedIds) != 1 || activated[0].AffectedIds[0] != "enemy" {
+		t.Fatalf("Expected the EMP to hit only the nearby enemy, got %v", activated)
+	}
+	for _, b := range state.Bots {
+		drained := b.Energy < 60
+		if drained != (b.Id == "enemy" || b.Id == "bot1") {
+			t.Errorf("Bot %s energy = %f", b.Id, b.Energy)
+		}
+	}
+}
+
+type testBoostPower struct{ BasePower }
+
+func (testBoostPower) Activate(robot *pb.BotState, _ *ClassDef) { robot.Heat = 0 }
+
+func TestRegisterPower(t *testing.T) {
+	RegisterPower(pb.PowerType(99), testBoostPower{BasePower{EnergyCost: 10, CooldownTicks: 5}})
+	defer func() {
+		powersMu.Lock()
+		delete(powers, pb.PowerType(99))
+		powersMu.Unlock()
+	}()
+
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "bot1", Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100, Energy: 100, Heat: 5},
+	}}
+
+	state = pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": {UsePower: pb.PowerType(99)}})
+
+	if state.Bots[0].Heat != 0 {
+		t.Errorf("Expected the registered power to vent heat, got %f", state.Bots[0].Heat)
+	}
+	if activated, _ := powerEvents(state.Events); len(activated) != 1 {
+		t.Errorf("Expected an activation event, got %v", activated)
+	}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// DefaultMatchID is used for the engine built from the daemon flags and for
+// clients that do not specify a match.
+const DefaultMatchID = "default-match"
+
+// Match pairs a simulation engine with the game loop driving it.
+type Match struct {
+	ID     string
+	Engine *SimulationEngine
+	Loop   *GameLoop
+}
+
+// MatchRegistry owns every match hosted by the engine process, keyed by match ID.
+type MatchRegistry struct {
+	mu      sync.RWMutex
+	matches map[string]*Match
+}
+
+func NewMatchRegistry() *MatchRegistry {
+	return &MatchRegistry{
+		matches: make(map[string]*Match),
+	}
+}
+
+// Add registers an engine under the given match ID.
+func (r *MatchRegistry) Add(id string, engine *SimulationEngine) (*Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.matches[id]; exists {
+		return nil, fmt.Errorf("match %s already exists", id)
+	}
+	engine.MatchID = id
+	m := &Match{ID: id, Engine: engine}
+	r.matches[id] = m
+	return m, nil
+}
+
+func (r *MatchRegistry) Get(id string) (*Match, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m, ok := r.matches[id]
+	return m, ok
+}
+
+// Remove drops a match from the registry. It does not stop its game loop.
+func (r *MatchRegistry) Remove(id string) (*Match, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m, ok := r.matches[id]
+	if ok {
+		delete(r.matches, id)
+	}
+	return m, ok
+}
+
+// List returns all registered matches sorted by ID.
+func (r *MatchRegistry) List() []*Match {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*Match, 0, len(r.matches))
+	for _, m := range r.matches {
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// Start creates and starts the game loop of a registered match.
+func (r *MatchRegistry) Start(id string, tickRate time.Duration, broadcast func(*pb.WorldState)) (*Match, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.matches[id]
+	if !ok {
+		return nil, fmt.Errorf("match %s not found", id)
+	}
+	if m.Loop != nil {
+		return nil, fmt.Errorf("match %s is already running", id)
+	}
+	m.Loop = NewGameLoop(m.Engine, tickRate, broadcast)
+	m.Loop.Start()
+	return m, nil
+}
+
+// Stop ends a match, halting its game loop and persisting the result, and
+// removes it from the registry.
+func (r *MatchRegistry) Stop(id string) (*Match, error) {
+	r.mu.RLock()
+	m, ok := r.matches[id]
+	var loop *GameLoop
+	if ok {
+		loop = m.Loop
+	}
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("match %s not found", id)
+	}
+
+	// Ended before removal so the final state still reaches the match's streams
+	if loop != nil {
+		loop.Stop()
+	} else {
+		m.Engine.Stop()
+	}
+	r.Remove(id)
+	return m, nil
+}
+
+// Loop returns the game loop of a started match.
+func (r *MatchRegistry) Loop(id string) (*GameLoop, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, ok := r.matches[id]
+	if !ok {
+		return nil, fmt.Errorf("match %s not found", id)
+	}
+	if m.Loop == nil {
+		return nil, fmt.Errorf("match %s has not been started", id)
+	}
+	return m.Loop, nil
+}
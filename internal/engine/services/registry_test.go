@@ -0,0 +1,49 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestMatchRegistry_IndependentMatches(t *testing.T) {
+	r := NewMatchRegistry()
+
+	if _, err := r.Add("m1", NewSimulationEngine(800, 600, nil)); err != nil {
+		t.Fatalf("Failed to add m1: %v", err)
+	}
+	if _, err := r.Add("m2", NewSimulationEngine(800, 600, nil)); err != nil {
+		t.Fatalf("Failed to add m2: %v", err)
+	}
+	if _, err := r.Add("m1", NewSimulationEngine(800, 600, nil)); err == nil {
+		t.Error("Expected error when adding duplicate match ID")
+	}
+
+	m1, _ := r.Get("m1")
+	if m1.Engine.MatchID != "m1" {
+		t.Errorf("Expected engine MatchID to be set, got %q", m1.Engine.MatchID)
+	}
+
+	if _, err := r.Start("m1", time.Hour, nil); err != nil {
+		t.Fatalf("Failed to start m1: %v", err)
+	}
+	if _, err := r.Start("m1", time.Hour, nil); err == nil {
+		t.Error("Expected error when starting a running match")
+	}
+
+	if _, err := r.Stop("m1"); err != nil {
+		t.Fatalf("Failed to stop m1: %v", err)
+	}
+	if m1.Engine.Status != pb.MatchStatus_FINISHED {
+		t.Errorf("Expected stopped match to be FINISHED, got %s", m1.Engine.Status)
+	}
+
+	list := r.List()
+	if len(list) != 1 || list[0].ID != "m2" {
+		t.Errorf("Expected only m2 to remain, got %d matches", len(list))
+	}
+	if list[0].Engine.Status != pb.MatchStatus_WAITING {
+		t.Errorf("Expected m2 to be unaffected, got %s", list[0].Engine.Status)
+	}
+}
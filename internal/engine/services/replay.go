@@ -0,0 +1,132 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sort"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// StateHash fingerprints a world state. The encoding is deterministic, so equal
+// states always hash the same.
+func StateHash(state *pb.WorldState) uint64 {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(state)
+	if err != nil {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write(data)
+	return h.Sum64()
+}
+
+// recordTick saves the inputs of the tick that produced state, and its hash.
+func (e *SimulationEngine) recordTick(joined []*pb.BotState, connections []*pb.ConnectionChange, intents map[string]*pb.BotIntent, stepped bool, state *pb.WorldState) {
+	record := &pb.TickRecord{
+		Tick:        state.Tick,
+		Joined:      joined,
+		Connections: connections,
+		StateHash:   StateHash(state),
+		Stepped:     stepped,
+	}
+	if state.Tick == 1 {
+		record.Arena = e.ArenaConfig
+	}
+
+	ids := make([]string, 0, len(intents))
+	for id := range intents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		record.Intents = append(record.Intents, &pb.RecordedIntent{BotId: id, Intent: intents[id]})
+	}
+
+	matchID := e.MatchID
+	if matchID == "" {
+		matchID = DefaultMatchID
+	}
+	payload, err := proto.Marshal(record)
+	if err != nil {
+		slog.Error("Failed to encode tick record", "match_id", matchID, "tick", state.Tick, "error", err)
+		return
+	}
+	if err := e.DB.SaveTickLog(&persistence.TickLog{MatchID: matchID, Tick: state.Tick, Payload: payload}); err != nil {
+		slog.Error("Failed to save tick record", "match_id", matchID, "tick", state.Tick, "error", err)
+	}
+}
+
+// LoadTickRecords reads the recorded ticks of a match from the database.
+func LoadTickRecords(db *persistence.Database, matchID string) ([]*pb.TickRecord, error) {
+	logs, err := db.GetTickLogs(matchID)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*pb.TickRecord, 0, len(logs))
+	for _, l := range logs {
+		record := &pb.TickRecord{}
+		if err := proto.Unmarshal(l.Payload, record); err != nil {
+			return nil, fmt.Errorf("tick %d: %w", l.Tick, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// VerifyReplay re-simulates recorded ticks from scratch and checks that every
+// state hash matches. When a match ID was run several times, the most recent
+// run is verified. It returns the number of ticks verified.
+func VerifyReplay(records []*pb.TickRecord) (int, error) {
+	records, err := latestRun(records)
+	if err != nil {
+		return 0, err
+	}
+
+	e := NewSimulationEngine(0, 0, nil)
+	if err := e.SetArenaConfig(proto.Clone(records[0].Arena).(*pb.ArenaConfig)); err != nil {
+		return 0, err
+	}
+	e.Status = pb.MatchStatus_RUNNING
+
+	for i, r := range records {
+		if r.Tick != e.CurrentTick+1 {
+			return i, fmt.Errorf("tick %d is missing from the recording", e.CurrentTick+1)
+		}
+		for _, bot := range r.Joined {
+			e.SetBot(bot.Id, proto.Clone(bot).(*pb.BotState))
+		}
+		e.connections = append(e.connections, r.Connections...)
+		for _, in := range r.Intents {
+			e.SetBotIntent(in.BotId, in.Intent)
+		}
+
+		var state *pb.WorldState
+		if r.Stepped {
+			state = e.StepTick()
+		} else {
+			state = e.Tick()
+		}
+		if hash := StateHash(state); hash != r.StateHash {
+			return i, fmt.Errorf("state diverged at tick %d: recorded hash %016x, got %016x", r.Tick, r.StateHash, hash)
+		}
+	}
+	return len(records), nil
+}
+
+// latestRun returns the records of the most recent run of a match, which
+// starts with the record carrying the arena.
+func latestRun(records []*pb.TickRecord) ([]*pb.TickRecord, error) {
+	start := -1
+	for i, r := range records {
+		if r.Arena != nil {
+			start = i
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("no recorded run found")
+	}
+	return records[start:], nil
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/codearena-platform/codearena-core/internal/persistence"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// playRecordedMatch runs a short brawl with the recorder on and returns its records.
+func playRecordedMatch(t *testing.T) []*pb.TickRecord {
+	db, err := persistence.NewDatabase(":memory:")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	e := NewSimulationEngine(800, 600, db)
+	e.MatchID = "replay-match"
+	e.SetArenaConfig(&pb.ArenaConfig{
+		Width:     800,
+		Height:    600,
+		Obstacles: []*pb.Obstacle{{Id: "rock", Position: &pb.Vector3{X: 400, Y: 300}, Radius: 30, Hp: 20}},
+	})
+	e.Status = pb.MatchStatus_RUNNING
+
+	positions := [][2]float32{{300, 300}, {500, 300}, {400, 150}, {400, 450}}
+	for i, pos := range positions {
+		id := fmt.Sprintf("bot_%d", i+1)
+		e.SetBot(id, &pb.BotState{Id: id, Position: &pb.Vector3{X: pos[0], Y: pos[1]}, Hull: 100, Energy: 100})
+	}
+
+	token := e.OpenSession("bot_3")
+	for tick := 0; tick < 120; tick++ {
+		if tick == 30 {
+			e.SetBot("late", &pb.BotState{Id: "late", Position: &pb.Vector3{X: 700, Y: 500}, Hull: 100, Energy: 100})
+		}
+		// bot_3 drops out for a while
+		if tick == 40 {
+			e.Disconnect("bot_3")
+		}
+		if tick == 70 {
+			e.ResumeSession(token)
+		}
+		for i, bot := range e.GetBotSlice() {
+			e.SetBotIntent(bot.Id, &pb.BotIntent{
+				MoveDistance:     float32(tick%7) - 3,
+				TurnDegrees:      float32((tick + i) % 11),
+				GunTurnDegrees:   float32(i*13 + tick%5),
+				RadarTurnDegrees: 10,
+				FirePower:        1,
+			})
+		}
+		e.Tick()
+		if e.Status == pb.MatchStatus_FINISHED {
+			break
+		}
+	}
+
+	records, err := LoadTickRecords(db, "replay-match")
+	if err != nil {
+		t.Fatalf("Failed to load records: %v", err)
+	}
+	if len(records) == 0 || records[0].Arena.GetSeed() == 0 {
+		t.Fatalf("Expected a seeded recording, got %d records", len(records))
+	}
+	return records
+}
+
+func TestVerifyReplay_ReproducesMatch(t *testing.T) {
+	records := playRecordedMatch(t)
+
+	ticks, err := VerifyReplay(records)
+	if err != nil {
+		t.Fatalf("Replay diverged: %v", err)
+	}
+	if ticks != len(records) {
+		t.Errorf("Expected %d verified ticks, got %d", len(records), ticks)
+	}
+
+	changes := 0
+	for _, r := range records {
+		changes += len(r.Connections)
+	}
+	if changes != 3 {
+		t.Errorf("Expected the session to be recorded opening, dropping and resuming, got %d changes", changes)
+	}
+}
+
+func TestVerifyReplay_DetectsTampering(t *testing.T) {
+	records := playRecordedMatch(t)
+
+	// Give one bot a different order halfway through
+	tampered := records[len(records)/2]
+	if len(tampered.Intents) == 0 {
+		t.Fatal("Expected recorded intents")
+	}
+	tampered.Intents[0].Intent.TurnDegrees += 45
+
+	if _, err := VerifyReplay(records); err == nil {
+		t.Error("Expected verification to fail for a tampered recording")
+	}
+}
+
+func TestPhysics_DeterministicOrder(t *testing.T) {
+	bots := make([]*pb.BotState, 0)
+	intents := make(map[string]*pb.BotIntent)
+	for i := 0; i < 12; i++ {
+		id := fmt.Sprintf("bot_%02d", i)
+		// Packed tightly so collisions chain between robots
+		bots = append(bots, &pb.BotState{Id: id, Position: &pb.Vector3{X: 200 + float32(i%4)*25, Y: 200 + float32(i/4)*25}, Hull: 100, Energy: 100})
+		intents[id] = &pb.BotIntent{MoveDistance: 5, TurnDegrees: float32(i * 30), FirePower: 1}
+	}
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+
+	var want uint64
+	for run := 0; run < 20; run++ {
+		st := NewPhysicsEngine().Update(&pb.WorldState{Bots: bots}, arena, intents)
+		hash := StateHash(st)
+		if run == 0 {
+			want = hash
+		} else if hash != want {
+			t.Fatalf("Run %d produced a different state", run)
+		}
+	}
+}
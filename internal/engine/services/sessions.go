@@ -0,0 +1,147 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// OpenSession issues the token a bot presents to resume its session after
+// its connection drops. From the next tick the bot is held to the arena's
+// intent deadline.
+func (e *SimulationEngine) OpenSession(botID string) string {
+	buf := make([]byte, 16)
+	rand.Read(buf)
+	token := hex.EncodeToString(buf)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.sessions == nil {
+		e.sessions = make(map[string]string)
+	}
+	e.sessions[token] = botID
+	e.connections = append(e.connections, &pb.ConnectionChange{BotId: botID, Connected: true})
+	return token
+}
+
+// SessionBot returns the bot a session token belongs to.
+func (e *SimulationEngine) SessionBot(token string) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	botID, ok := e.sessions[token]
+	return botID, ok
+}
+
+// ResumeSession re-binds a session token to its bot, which stops idling from
+// the next tick. It fails once the bot has died or forfeited.
+func (e *SimulationEngine) ResumeSession(token string) (*pb.BotState, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	botID, ok := e.sessions[token]
+	if !ok {
+		return nil, fmt.Errorf("unknown session token")
+	}
+	bot, alive := e.Bots[botID]
+	if !alive {
+		delete(e.sessions, token)
+		return nil, fmt.Errorf("bot %s is no longer in the match", botID)
+	}
+	e.connections = append(e.connections, &pb.ConnectionChange{BotId: botID, Connected: true})
+	return bot, nil
+}
+
+// Disconnect marks a bot's connection as lost. From the next tick the bot
+// idles until it resumes its session or the grace period runs out.
+func (e *SimulationEngine) Disconnect(botID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.connections = append(e.connections, &pb.ConnectionChange{BotId: botID})
+}
+
+// reconnectGrace returns the ticks a disconnected bot idles before it
+// forfeits.
+func (e *SimulationEngine) reconnectGrace() int64 {
+	if grace := e.ArenaConfig.GetReconnectGraceTicks(); grace > 0 {
+		return grace
+	}
+	return DefaultReconnectGraceTicks
+}
+
+// applyConnections records this tick's connection changes, announcing drops
+// and reconnects as events, and replaces the intents of disconnected bots
+// with one that stops them. The caller must hold e.mu.
+func (e *SimulationEngine) applyConnections(changes []*pb.ConnectionChange, intents map[string]*pb.BotIntent) {
+	for _, c := range changes {
+		bot, alive := e.Bots[c.BotId]
+		if !alive {
+			continue
+		}
+		since, offline := e.offline[c.BotId]
+		if c.Connected {
+			if e.remote == nil {
+				e.remote = make(map[string]int64)
+			}
+			e.remote[c.BotId] = e.CurrentTick
+		}
+		switch {
+		case !c.Connected && !offline:
+			if e.offline == nil {
+				e.offline = make(map[string]int64)
+			}
+			e.offline[c.BotId] = e.CurrentTick
+			e.Events = append(e.Events, &pb.SimulationEvent{
+				Tick: e.CurrentTick,
+				Event: &pb.SimulationEvent_BotDisconnected{
+					BotDisconnected: &pb.BotDisconnectedEvent{BotId: bot.Id, TeamId: bot.TeamId, GraceTicks: e.reconnectGrace()},
+				},
+			})
+		case c.Connected && offline:
+			delete(e.offline, c.BotId)
+			e.Events = append(e.Events, &pb.SimulationEvent{
+				Tick: e.CurrentTick,
+				Event: &pb.SimulationEvent_BotReconnected{
+					BotReconnected: &pb.BotReconnectedEvent{BotId: bot.Id, TeamId: bot.TeamId, OfflineTicks: e.CurrentTick - since},
+				},
+			})
+		}
+	}
+
+	for id := range e.offline {
+		if _, alive := e.Bots[id]; !alive {
+			delete(e.offline, id)
+			continue
+		}
+		intents[id] = &pb.BotIntent{}
+	}
+}
+
+// forfeitDisconnected removes the bots whose grace period ran out, as deaths
+// without a killer.
+func (e *SimulationEngine) forfeitDisconnected() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ids := make([]string, 0, len(e.offline))
+	for id, since := range e.offline {
+		if e.CurrentTick-since >= e.reconnectGrace() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		delete(e.offline, id)
+		delete(e.Bots, id)
+		if st, ok := e.Stats[id]; ok {
+			st.Deaths++
+		}
+		e.Events = append(e.Events, &pb.SimulationEvent{
+			Tick: e.CurrentTick,
+			Event: &pb.SimulationEvent_Death{
+				Death: &pb.DeathEvent{BotId: id, KillerId: DisconnectSource},
+			},
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func sessionEngine(grace int64) *SimulationEngine {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Seed: 1, ReconnectGraceTicks: grace})
+	e.SetBot("a", &pb.BotState{Id: "a", Position: &pb.Vector3{X: 200, Y: 300}, Hull: 100, Energy: 100})
+	e.SetBot("b", &pb.BotState{Id: "b", Position: &pb.Vector3{X: 600, Y: 300}, Hull: 100, Energy: 100})
+	e.Status = pb.MatchStatus_RUNNING
+	return e
+}
+
+func TestSessions_DisconnectAndResume(t *testing.T) {
+	e := sessionEngine(100)
+	token := e.OpenSession("a")
+
+	e.SetBotIntent("a", &pb.BotIntent{MoveDistance: 500})
+	e.Tick()
+	e.Disconnect("a")
+	// Intents sent before the drop was noticed are dropped too
+	e.SetBotIntent("a", &pb.BotIntent{TurnDegrees: 5})
+	state := e.Tick()
+	if ev := findEvent(state, func(ev *pb.SimulationEvent) bool { return ev.GetBotDisconnected() != nil }); ev == nil || ev.GetBotDisconnected().GraceTicks != 100 {
+		t.Errorf("Expected a disconnect event with 100 grace ticks, got %v", ev)
+	}
+
+	for i := 0; i < 10; i++ {
+		e.Tick()
+	}
+	bot := e.Bots["a"]
+	if bot.Velocity != 0 || bot.DistanceRemaining != 0 || bot.Heading != 0 {
+		t.Errorf("Expected the disconnected bot to idle, got velocity %v, distance %v, heading %v", bot.Velocity, bot.DistanceRemaining, bot.Heading)
+	}
+
+	resumed, err := e.ResumeSession(token)
+	if err != nil || resumed.Id != "a" {
+		t.Fatalf("Expected to resume bot a, got %v, %v", resumed, err)
+	}
+	e.SetBotIntent("a", &pb.BotIntent{MoveDistance: 50})
+	state = e.Tick()
+	if ev := findEvent(state, func(ev *pb.SimulationEvent) bool { return ev.GetBotReconnected() != nil }); ev == nil || ev.GetBotReconnected().OfflineTicks != 11 {
+		t.Errorf("Expected a reconnect event after 11 offline ticks, got %v", ev)
+	}
+	if e.Bots["a"].Velocity == 0 {
+		t.Error("Expected the resumed bot to follow its intents again")
+	}
+
+	if _, err := e.ResumeSession("bogus"); err == nil {
+		t.Error("Expected an error for an unknown token")
+	}
+}
+
+func TestSessions_ForfeitAfterGrace(t *testing.T) {
+	e := sessionEngine(5)
+	token := e.OpenSession("a")
+	e.Disconnect("a")
+
+	var state *pb.WorldState
+	for i := 0; i < 6 && e.Status != pb.MatchStatus_FINISHED; i++ {
+		state = e.Tick()
+	}
+	if _, alive := e.Bots["a"]; alive {
+		t.Fatal("Expected the bot to forfeit after the grace period")
+	}
+	death := findEvent(state, func(ev *pb.SimulationEvent) bool { return ev.GetDeath() != nil })
+	if death == nil || death.GetDeath().KillerId != DisconnectSource || e.Stats["a"].Deaths != 1 {
+		t.Errorf("Expected a disconnect death, got %v", death)
+	}
+	if e.Status != pb.MatchStatus_FINISHED {
+		t.Errorf("Expected the remaining bot to win, got %s", e.Status)
+	}
+	if _, err := e.ResumeSession(token); err == nil {
+		t.Error("Expected an expired session not to resume")
+	}
+}
+
+func findEvent(state *pb.WorldState, match func(*pb.SimulationEvent) bool) *pb.SimulationEvent {
+	for _, ev := range state.Events {
+		if match(ev) {
+			return ev
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"math"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// SpawnAssignment records where a bot entered the match.
+type SpawnAssignment struct {
+	BotID   string  `json:"bot_id"`
+	TeamID  string  `json:"team_id,omitempty"`
+	Point   int     `json:"point"` // Index into ArenaConfig.spawn_points; -1 when generated
+	X       float32 `json:"x"`
+	Y       float32 `json:"y"`
+	Heading float32 `json:"heading"`
+}
+
+// AssignSpawn places a joining bot and records the assignment. Spawn points
+// reserved for the bot's team are used first, then open ones. Once none are
+// left a position is generated from the match RNG, mirroring the first bot's
+// spawn through the arena center for the second bot of a 1v1.
+func (e *SimulationEngine) AssignSpawn(bot *pb.BotState) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	a := SpawnAssignment{BotID: bot.Id, TeamID: bot.TeamId, Point: -1}
+	if i, ok := e.freeSpawnPoint(bot.TeamId); ok {
+		p := e.ArenaConfig.SpawnPoints[i]
+		a.Point, a.X, a.Y, a.Heading = i, p.Position.X, p.Position.Y, e.Physics.normalizeAngle(p.Heading)
+	} else {
+		a.X, a.Y, a.Heading = e.generateSpawn(e.Physics.Classes.Get(bot.Class).Radius)
+	}
+
+	bot.Position = &pb.Vector3{X: a.X, Y: a.Y, Z: 0}
+	bot.Heading, bot.GunHeading, bot.RadarHeading = a.Heading, a.Heading, a.Heading
+	e.Spawns = append(e.Spawns, a)
+}
+
+// freeSpawnPoint returns the first unassigned spawn point reserved for team,
+// or failing that the first unassigned open one.
+func (e *SimulationEngine) freeSpawnPoint(team string) (int, bool) {
+	taken := make(map[int]bool, len(e.Spawns))
+	for _, a := range e.Spawns {
+		taken[a.Point] = true
+	}
+	points := e.ArenaConfig.SpawnPoints
+	if team != "" {
+		for i, p := range points {
+			if !taken[i] && p.TeamId == team {
+				return i, true
+			}
+		}
+	}
+	for i, p := range points {
+		if !taken[i] && p.TeamId == "" {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// generateSpawn picks a position for a robot of the given radius that keeps
+// clear of walls, obstacles and other robots, facing the arena center. When
+// the arena is too crowded for that, the roomiest candidate is used. The
+// second robot of a 1v1 match mirrors the first through the center.
+func (e *SimulationEngine) generateSpawn(radius float32) (x, y, heading float32) {
+	w, h := e.ArenaConfig.Width, e.ArenaConfig.Height
+
+	if e.ArenaConfig.MaxBots == 2 && len(e.Spawns) == 1 && len(e.Bots) == 1 && e.Spawns[0].Point < 0 {
+		first := e.Spawns[0]
+		mx, my := w-first.X, h-first.Y
+		if e.spawnSlack(mx, my, radius) >= 0 {
+			return mx, my, e.Physics.normalizeAngle(first.Heading + 180)
+		}
+	}
+
+	best := math.Inf(-1)
+	for i := 0; i < SpawnAttempts; i++ {
+		cx := spawnCoordinate(e.Rand.Float64(), w, radius)
+		cy := spawnCoordinate(e.Rand.Float64(), h, radius)
+		slack := e.spawnSlack(cx, cy, radius)
+		if slack > best {
+			x, y, best = cx, cy, slack
+		}
+		if slack >= 0 {
+			break
+		}
+	}
+
+	bearing := math.Atan2(float64(w/2-x), float64(y-h/2)) * 180 / math.Pi
+	return x, y, e.Physics.normalizeAngle(float32(bearing))
+}
+
+// spawnSlack returns how far a robot at (x, y) is from breaking the nearest
+// spawn distance rule; negative when it breaks one.
+func (e *SimulationEngine) spawnSlack(x, y, radius float32) float64 {
+	wall := float64(radius + SpawnClearance)
+	slack := math.Min(
+		math.Min(float64(x), float64(e.ArenaConfig.Width-x)),
+		math.Min(float64(y), float64(e.ArenaConfig.Height-y)),
+	) - wall
+
+	for _, o := range e.Obstacles {
+		dist := math.Hypot(float64(x-o.Position.X), float64(y-o.Position.Y))
+		slack = math.Min(slack, dist-float64(o.Radius+radius+SpawnClearance))
+	}
+	for _, bot := range e.Bots {
+		dist := math.Hypot(float64(x-bot.Position.X), float64(y-bot.Position.Y))
+		slack = math.Min(slack, dist-SpawnBotDistance)
+	}
+	return slack
+}
+
+// spawnCoordinate maps r in [0, 1) onto the part of an axis of the given
+// length a robot fits in, or its middle when it does not fit.
+func spawnCoordinate(r float64, length, radius float32) float32 {
+	span := length - 2*radius
+	if span <= 0 {
+		return length / 2
+	}
+	return radius + float32(r)*span
+}
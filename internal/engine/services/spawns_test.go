@@ -0,0 +1,115 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func spawnBot(e *SimulationEngine, id, team string) *pb.BotState {
+	bot := &pb.BotState{Id: id, TeamId: team, Class: ClassTank}
+	e.AssignSpawn(bot)
+	e.SetBot(id, bot)
+	return bot
+}
+
+func TestAssignSpawn_TeamPoints(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Seed: 1, SpawnPoints: []*pb.SpawnPoint{
+		{Position: &pb.Vector3{X: 100, Y: 100}, TeamId: "red", Heading: 90},
+		{Position: &pb.Vector3{X: 700, Y: 500}, TeamId: "blue", Heading: 270},
+		{Position: &pb.Vector3{X: 400, Y: 100}},
+	}})
+
+	blue := spawnBot(e, "b1", "blue")
+	if blue.Position.X != 700 || blue.Position.Y != 500 || blue.Heading != 270 {
+		t.Errorf("Expected the blue bot at the blue point, got %v heading %v", blue.Position, blue.Heading)
+	}
+	red := spawnBot(e, "r1", "red")
+	if red.Position.X != 100 || red.Position.Y != 100 || red.GunHeading != 90 {
+		t.Errorf("Expected the red bot at the red point, got %v gun %v", red.Position, red.GunHeading)
+	}
+	// The red point is taken, so the next red bot gets the open one
+	red2 := spawnBot(e, "r2", "red")
+	if red2.Position.X != 400 || red2.Position.Y != 100 {
+		t.Errorf("Expected the open point, got %v", red2.Position)
+	}
+	// Every point is taken; the fallback generator takes over
+	spawnBot(e, "r3", "red")
+
+	want := []int{1, 0, 2, -1}
+	for i, a := range e.Spawns {
+		if a.Point != want[i] {
+			t.Errorf("Spawn %d (%s): expected point %d, got %d", i, a.BotID, want[i], a.Point)
+		}
+	}
+}
+
+func TestAssignSpawn_Generated(t *testing.T) {
+	e := NewSimulationEngine(1000, 800, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 1000, Height: 800, Seed: 42, Obstacles: []*pb.Obstacle{
+		{Id: "rock", Position: &pb.Vector3{X: 500, Y: 400}, Radius: 80},
+	}})
+
+	radius := float64(e.Physics.Classes.Get(ClassTank).Radius)
+	var bots []*pb.BotState
+	for _, id := range []string{"bot1", "bot2", "bot3", "bot4"} {
+		bots = append(bots, spawnBot(e, id, ""))
+	}
+	for i, b := range bots {
+		x, y := float64(b.Position.X), float64(b.Position.Y)
+		if wall := math.Min(math.Min(x, 1000-x), math.Min(y, 800-y)); wall < radius+SpawnClearance {
+			t.Errorf("%s spawned %.1f from a wall", b.Id, wall)
+		}
+		if d := math.Hypot(x-500, y-400); d < 80+radius+SpawnClearance {
+			t.Errorf("%s spawned %.1f from the obstacle", b.Id, d)
+		}
+		for _, other := range bots[:i] {
+			if d := math.Hypot(x-float64(other.Position.X), y-float64(other.Position.Y)); d < SpawnBotDistance {
+				t.Errorf("%s spawned %.1f from %s", b.Id, d, other.Id)
+			}
+		}
+	}
+
+	// The same seed gives the same spawns
+	again := NewSimulationEngine(1000, 800, nil)
+	again.SetArenaConfig(&pb.ArenaConfig{Width: 1000, Height: 800, Seed: 42, Obstacles: e.ArenaConfig.Obstacles})
+	for _, b := range bots {
+		if got := spawnBot(again, b.Id, ""); got.Position.X != b.Position.X || got.Position.Y != b.Position.Y {
+			t.Errorf("Expected %s at %v again, got %v", b.Id, b.Position, got.Position)
+		}
+	}
+}
+
+func TestAssignSpawn_MirroredDuel(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Seed: 7, MaxBots: 2})
+
+	first := spawnBot(e, "bot1", "")
+	second := spawnBot(e, "bot2", "")
+
+	if second.Position.X != 800-first.Position.X || second.Position.Y != 600-first.Position.Y {
+		t.Errorf("Expected %v mirrored through the center, got %v", first.Position, second.Position)
+	}
+	if want := e.Physics.normalizeAngle(first.Heading + 180); second.Heading != want {
+		t.Errorf("Expected heading %v, got %v", want, second.Heading)
+	}
+	// Both face the center
+	bearing := float32(math.Atan2(float64(400-first.Position.X), float64(first.Position.Y-300)) * 180 / math.Pi)
+	if diff := math.Abs(float64(e.Physics.normalizeAngle(bearing) - first.Heading)); diff > 0.01 {
+		t.Errorf("Expected the first bot to face the center, off by %v", diff)
+	}
+}
+
+func TestAssignSpawn_MirrorsOnlyDuels(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Seed: 7, MaxBots: 4})
+
+	first := spawnBot(e, "bot1", "")
+	second := spawnBot(e, "bot2", "")
+
+	if second.Position.X == 800-first.Position.X && second.Position.Y == 600-first.Position.Y {
+		t.Errorf("Expected no mirroring outside a 1v1 match, got %v and %v", first.Position, second.Position)
+	}
+}
@@ -1,65 +1,90 @@
 package services
 
 import (
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"sort"
 	"time"
+	"unicode/utf8"
 
 	"github.com/codearena-platform/codearena-core/internal/persistence"
 	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// StepTick executes a single simulation step of a paused match. Networked
+// bots cannot keep pace with ticks stepped back to back, so intent deadlines
+// do not apply to it.
+func (e *SimulationEngine) StepTick() *pb.WorldState {
+	e.mu.Lock()
+	e.stepped = true
+	e.mu.Unlock()
+	return e.Tick()
+}
+
 // Tick executes a single simulation step
 func (e *SimulationEngine) Tick() *pb.WorldState {
-	e.CurrentTick++
+	e.driveControllers()
 
-	e.mu.RLock()
-	// 1. Prepare Current World State Snapshot for Physics
+	e.mu.Lock()
+	e.CurrentTick++
+	// 1. Take this tick's intents, joins and connection changes, then snapshot
+	// the world for physics. Deadlines decide which intents networked bots
+	// play; intents that arrive while the tick runs apply to the next one.
+	intents := e.Intents
+	e.Intents = make(map[string]*pb.BotIntent)
+	joined := e.joined
+	e.joined = nil
+	connections := e.connections
+	e.connections = nil
+	stepped := e.stepped
+	e.stepped = false
+	e.applyConnections(connections, intents)
+	applied := intents
+	if !stepped {
+		applied = e.applyDeadlines(intents)
+	}
 	currentState := &pb.WorldState{
-		Tick:    e.CurrentTick - 1,
-		Bots:    e.getBotSliceInternal(),
-		Bullets: e.Bullets,
-		Zone:    e.Zone,
-		Events:  nil,
+		Tick:      e.CurrentTick - 1,
+		Bots:      e.getBotSliceInternal(),
+		Bullets:   e.Bullets,
+		Zone:      e.Zone,
+		Obstacles: e.Obstacles,
+		Pickups:   e.Pickups,
+		Events:    nil,
 	}
-	e.mu.RUnlock()
+	e.mu.Unlock()
 
 	// 2. Physics Update
-	newState := e.Physics.Update(currentState, e.ArenaConfig, e.Intents)
+	newState := e.Physics.Update(currentState, e.ArenaConfig, applied)
 
 	// 3. Update Engine State
-	e.updateFromState(newState)
+	e.updateFromState(currentState, newState)
+	e.recordStats(newState.Events)
+	e.relayTeamMessages(applied)
+	e.forfeitDisconnected()
 
 	// 4. Handle Higher Level Game Logic
 	e.checkWinCondition()
 
 	// 6. Persist Events to DB
-	if e.DB != nil && len(e.Events) > 0 {
-		matchID := e.MatchID
-		if matchID == "" {
-			matchID = "default-match"
-		}
-		dbEvents := make([]persistence.EventLog, 0, len(e.Events))
-		for _, ev := range e.Events {
-			payload, _ := protojson.Marshal(ev)
-			dbEvents = append(dbEvents, persistence.EventLog{
-				MatchID: matchID,
-				Tick:    ev.Tick,
-				Type:    fmt.Sprintf("%T", ev.Event),
-				Payload: string(payload),
-			})
-		}
-		e.DB.SaveEvents(dbEvents)
-	}
+	e.saveEvents()
 
 	// 7. Get final state for return
 	state := e.GetWorldState()
 
-	// 8. Clear events and intents for next tick
+	// 8. Record the tick so the match can be re-simulated and replayed
+	if e.DB != nil {
+		e.recordTick(joined, connections, intents, stepped, state)
+		e.recordFrame(state)
+	}
+
+	// 9. Clear events for next tick
 	e.mu.Lock()
 	e.Events = make([]*pb.SimulationEvent, 0)
-	e.Intents = make(map[string]*pb.BotIntent)
+	e.lastState = state
+	e.noteStateTime()
 	e.mu.Unlock()
 
 	return state
@@ -86,7 +111,7 @@ func (e *SimulationEngine) GetBotSlice() []*pb.BotState {
 	return e.getBotSliceInternal()
 }
 
-func (e *SimulationEngine) updateFromState(newState *pb.WorldState) {
+func (e *SimulationEngine) updateFromState(oldState, newState *pb.WorldState) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -97,15 +122,18 @@ func (e *SimulationEngine) updateFromState(newState *pb.WorldState) {
 		e.Bots[b.Id] = b // Update existing/new bots
 	}
 
-	// 2. Remove bots that are no longer in the physics state
-	for id := range e.Bots {
-		if !aliveBots[id] {
-			delete(e.Bots, id)
+	// 2. Remove bots that died this tick. Bots that joined while physics ran
+	// were not simulated and stay for the next tick.
+	for _, b := range oldState.Bots {
+		if !aliveBots[b.Id] {
+			delete(e.Bots, b.Id)
 		}
 	}
 
 	e.Bullets = newState.Bullets
 	e.Zone = newState.Zone
+	e.Obstacles = newState.Obstacles
+	e.Pickups = newState.Pickups
 
 	if len(newState.Events) > 0 {
 		e.Events = append(e.Events, newState.Events...)
@@ -115,13 +143,84 @@ func (e *SimulationEngine) updateFromState(newState *pb.WorldState) {
 func (e *SimulationEngine) GetWorldState() *pb.WorldState {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
+	return e.getWorldStateInternal()
+}
+
+// StateForBot returns the current state as a bot sees it, filtered while the
+// engine is locked so a tick cannot change it halfway.
+func (e *SimulationEngine) StateForBot(botID string) *pb.WorldState {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.Physics.FilterStateForBot(botID, e.getWorldStateInternal())
+}
+
+func (e *SimulationEngine) getWorldStateInternal() *pb.WorldState {
 	return &pb.WorldState{
-		Tick:    e.CurrentTick,
-		Status:  e.Status,
-		Bots:    e.getBotSliceInternal(),
-		Bullets: e.Bullets,
-		Zone:    e.Zone,
-		Events:  e.Events,
+		Tick:      e.CurrentTick,
+		Status:    e.Status,
+		Bots:      e.getBotSliceInternal(),
+		Bullets:   e.Bullets,
+		Zone:      e.Zone,
+		Obstacles: e.Obstacles,
+		Pickups:   e.Pickups,
+		Events:    e.Events,
+	}
+}
+
+// recordStats credits damage and kills from this tick's events to the bots involved.
+func (e *SimulationEngine) recordStats(events []*pb.SimulationEvent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, ev := range events {
+		if hit := ev.GetBulletHitTarget(); hit != nil {
+			if st, ok := e.Stats[hit.OwnerId]; ok {
+				st.DamageDealt += hit.Damage
+			}
+		} else if death := ev.GetDeath(); death != nil {
+			if st, ok := e.Stats[death.BotId]; ok {
+				st.Deaths++
+			}
+			// Suicides and team kills earn nothing
+			victim := e.Stats[death.BotId]
+			if st, ok := e.Stats[death.KillerId]; ok && death.KillerId != death.BotId {
+				if victim == nil || !sameTeam(st.TeamID, victim.TeamID) {
+					st.Kills++
+				}
+			}
+		}
+	}
+}
+
+// relayTeamMessages turns this tick's intent messages into events for the sender's team.
+func (e *SimulationEngine) relayTeamMessages(intents map[string]*pb.BotIntent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, bot := range e.getBotSliceInternal() {
+		intent := intents[bot.Id]
+		if intent == nil || intent.TeamMessage == "" || bot.TeamId == "" {
+			continue
+		}
+		msg := intent.TeamMessage
+		if len(msg) > TeamMessageMaxLength {
+			// Cut on a rune boundary so the message stays valid UTF-8
+			cut := TeamMessageMaxLength
+			for cut > 0 && !utf8.RuneStart(msg[cut]) {
+				cut--
+			}
+			msg = msg[:cut]
+		}
+		e.Events = append(e.Events, &pb.SimulationEvent{
+			Tick: e.CurrentTick,
+			Event: &pb.SimulationEvent_TeamMessage{
+				TeamMessage: &pb.TeamMessageEvent{
+					SenderId: bot.Id,
+					TeamId:   bot.TeamId,
+					Message:  msg,
+				},
+			},
+		})
 	}
 }
 
@@ -130,47 +229,181 @@ func (e *SimulationEngine) checkWinCondition() {
 		return
 	}
 
-	aliveCount := len(e.Bots)
-	if aliveCount == 0 && e.CurrentTick > 1000 {
-		e.Status = pb.MatchStatus_FINISHED
-		now := time.Now()
-
-		matchID := e.MatchID
-		if matchID == "" {
-			matchID = "match-" + now.Format("20060102-150405")
+	e.mu.Lock()
+	var result *MatchResult
+	for _, cond := range e.WinConditions {
+		if r, done := cond.Evaluate(e); done {
+			result = r
+			break
 		}
+	}
+	e.mu.Unlock()
 
-		e.Events = append(e.Events, &pb.SimulationEvent{
-			Tick: e.CurrentTick,
-			Event: &pb.SimulationEvent_MatchFinished{
-				MatchFinished: &pb.MatchFinishedEvent{},
+	if result != nil {
+		e.finish(result)
+	}
+}
+
+// Stop ends the match before a win condition is met, deciding the winner by
+// the arena's tiebreak as if its time had run out. It returns the final state,
+// or nil when the match had already finished. It must not run concurrently
+// with Tick.
+func (e *SimulationEngine) Stop() *pb.WorldState {
+	e.mu.Lock()
+	if e.Status == pb.MatchStatus_FINISHED {
+		e.mu.Unlock()
+		return nil
+	}
+	result := e.tiebreakResult(e.ArenaConfig.Tiebreak, teamPlay(e.ArenaConfig))
+	e.mu.Unlock()
+
+	e.finish(result)
+	e.saveEvents()
+	state := e.GetWorldState()
+
+	e.mu.Lock()
+	e.Events = make([]*pb.SimulationEvent, 0)
+	e.mu.Unlock()
+	return state
+}
+
+// finish ends the match with result, announcing it to the bots and persisting
+// it.
+func (e *SimulationEngine) finish(result *MatchResult) {
+	e.mu.Lock()
+	if e.Status == pb.MatchStatus_FINISHED {
+		e.mu.Unlock()
+		return
+	}
+	e.Status = pb.MatchStatus_FINISHED
+	e.Events = append(e.Events, &pb.SimulationEvent{
+		Tick: e.CurrentTick,
+		Event: &pb.SimulationEvent_MatchFinished{
+			MatchFinished: &pb.MatchFinishedEvent{
+				WinnerId:  result.WinnerID,
+				TeamStats: e.getTeamStatsInternal(),
 			},
+		},
+	})
+	stats := make([]BotStats, 0, len(e.Stats))
+	for _, id := range e.sortedStatIDs() {
+		stats = append(stats, *e.Stats[id])
+	}
+	spawns := append([]SpawnAssignment(nil), e.Spawns...)
+	e.mu.Unlock()
+
+	if e.DB != nil {
+		e.persistResult(result, stats, spawns)
+	}
+}
+
+// saveEvents persists the events of the current tick.
+func (e *SimulationEngine) saveEvents() {
+	if e.DB == nil || len(e.Events) == 0 {
+		return
+	}
+	matchID := e.MatchID
+	if matchID == "" {
+		matchID = DefaultMatchID
+	}
+	dbEvents := make([]persistence.EventLog, 0, len(e.Events))
+	for _, ev := range e.Events {
+		payload, _ := protojson.Marshal(ev)
+		dbEvents = append(dbEvents, persistence.EventLog{
+			MatchID: matchID,
+			Tick:    ev.Tick,
+			Type:    fmt.Sprintf("%T", ev.Event),
+			Payload: string(payload),
 		})
+	}
+	e.DB.SaveEvents(dbEvents)
+}
 
-		// Persistence Logic
-		if e.DB != nil {
-			match := &persistence.Match{
-				ID:          matchID,
-				Status:      "FINISHED",
-				ArenaWidth:  e.ArenaConfig.Width,
-				ArenaHeight: e.ArenaConfig.Height,
-				CreatedAt:   now.Add(-time.Duration(e.CurrentTick) * 16 * time.Millisecond),
-				FinishedAt:  &now,
-			}
-			e.DB.CreateMatch(match)
-
-			// Record stats for all participating bots (even dead ones)
-			// Assuming e.Bots only contains ALIVE bots.
-			// We need to track all bots that were in the match.
-			// For now, let's just record for the bots currently in the map.
-			for id, bot := range e.Bots {
-				e.DB.UpsertBot(&persistence.Bot{
-					ID:    id,
-					Name:  bot.Id, // Fallback
-					Image: "unknown",
-				})
-				// If aliveCount was 1, we could determine a winner.
-			}
+// markStarted notes when the match started and marks it as running in the
+// database, recording it there if it was never created.
+func (e *SimulationEngine) markStarted() {
+	e.mu.Lock()
+	if e.startedAt.IsZero() {
+		e.startedAt = time.Now()
+	}
+	startedAt := e.startedAt
+	e.mu.Unlock()
+
+	if e.DB == nil {
+		return
+	}
+	matchID := e.MatchID
+	if matchID == "" {
+		matchID = DefaultMatchID
+	}
+	match := &persistence.Match{
+		ID:          matchID,
+		Status:      "RUNNING",
+		ArenaWidth:  e.ArenaConfig.Width,
+		ArenaHeight: e.ArenaConfig.Height,
+		Seed:        e.ArenaConfig.Seed,
+		CreatedAt:   startedAt,
+	}
+	if err := e.DB.StartMatch(match); err != nil {
+		slog.Error("Failed to mark match as running", "match_id", matchID, "error", err)
+	}
+}
+
+// persistResult records the finished match, its winner, spawn assignments and
+// per-bot stats.
+func (e *SimulationEngine) persistResult(result *MatchResult, stats []BotStats, spawns []SpawnAssignment) {
+	now := time.Now()
+
+	matchID := e.MatchID
+	if matchID == "" {
+		matchID = "match-" + now.Format("20060102-150405")
+	}
+
+	spawnsJSON, err := json.Marshal(spawns)
+	if err != nil {
+		slog.Error("Failed to encode spawn assignments", "match_id", matchID, "error", err)
+	}
+
+	match := &persistence.Match{
+		ID:          matchID,
+		Status:      "FINISHED",
+		WinnerID:    result.WinnerID,
+		ArenaWidth:  e.ArenaConfig.Width,
+		ArenaHeight: e.ArenaConfig.Height,
+		Seed:        e.ArenaConfig.Seed,
+		Spawns:      string(spawnsJSON),
+		CreatedAt:   e.startedAt,
+		FinishedAt:  &now,
+	}
+	if match.CreatedAt.IsZero() {
+		match.CreatedAt = now
+	}
+	if err := e.DB.FinishMatch(match); err != nil {
+		slog.Error("Failed to persist match result", "match_id", matchID, "error", err)
+	}
+
+	winners := make(map[string]bool, len(result.Winners))
+	for _, id := range result.Winners {
+		winners[id] = true
+	}
+
+	// Record stats for every bot that took part, including dead ones
+	for _, st := range stats {
+		name := st.Name
+		if name == "" {
+			name = st.BotID // Fallback
+		}
+		e.DB.EnsureBot(&persistence.Bot{
+			ID:    st.BotID,
+			Name:  name,
+			Image: "unknown",
+		})
+		e.DB.RecordBotStats(st.BotID, st.Kills, st.Deaths)
+		if st.OnTime > 0 || st.SkippedTurns > 0 || st.Responses > 0 {
+			e.DB.RecordBotResponses(st.BotID, st.OnTime, st.SkippedTurns, st.StaleIntents, st.Responses, st.Latency)
+		}
+		if winners[st.BotID] {
+			e.DB.IncrementBotWin(st.BotID)
 		}
 	}
 }
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"fmt"
+	"math"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// Arena Limits
+const (
+	MinArenaSize = RobotRadius * 4
+	MaxArenaSize = 10000.0
+)
+
+// ValidateArenaConfig checks that an arena can be simulated: sane dimensions,
+// obstacles and zones inside the arena without overlapping each other, known
+// pickups and spawn points inside the arena, non-negative limits, known match
+// rules and class set, and a shrinking zone schedule that only ever shrinks.
+func ValidateArenaConfig(cfg *pb.ArenaConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("arena config is required")
+	}
+
+	// NaN fails every comparison, so it would slip past the range checks
+	if !finite(cfg.Width, cfg.Height) {
+		return fmt.Errorf("arena dimensions %gx%g must be finite", cfg.Width, cfg.Height)
+	}
+	if cfg.Width < MinArenaSize || cfg.Height < MinArenaSize {
+		return fmt.Errorf("arena dimensions %gx%g are below the minimum of %g", cfg.Width, cfg.Height, float32(MinArenaSize))
+	}
+	if cfg.Width > MaxArenaSize || cfg.Height > MaxArenaSize {
+		return fmt.Errorf("arena dimensions %gx%g exceed the maximum of %g", cfg.Width, cfg.Height, float32(MaxArenaSize))
+	}
+	if cfg.MaxBots < 0 {
+		return fmt.Errorf("max_bots must not be negative, got %d", cfg.MaxBots)
+	}
+	if cfg.MatchDurationTicks < 0 {
+		return fmt.Errorf("match_duration_ticks must not be negative, got %d", cfg.MatchDurationTicks)
+	}
+	if cfg.ReconnectGraceTicks < 0 {
+		return fmt.Errorf("reconnect_grace_ticks must not be negative, got %d", cfg.ReconnectGraceTicks)
+	}
+	if d := cfg.IntentDeadline; d != nil {
+		if d.Ticks < 0 || d.MaxSkippedTurns < 0 {
+			return fmt.Errorf("intent_deadline ticks and max_skipped_turns must not be negative")
+		}
+		if d.OnMiss != "" && d.OnMiss != OnMissSkip && d.OnMiss != OnMissReuse {
+			return fmt.Errorf("unknown intent_deadline on_miss %q", d.OnMiss)
+		}
+	}
+
+	if _, ok := DefaultClasses.Set(cfg.ClassSet); !ok {
+		return fmt.Errorf("unknown class_set %q", cfg.ClassSet)
+	}
+
+	switch cfg.WinCondition {
+	case "", WinLastBotStanding, WinLastTeamStanding:
+	case WinScoreTarget:
+		if cfg.ScoreTarget <= 0 {
+			return fmt.Errorf("score_target must be positive for %s", WinScoreTarget)
+		}
+	default:
+		return fmt.Errorf("unknown win_condition %q", cfg.WinCondition)
+	}
+	switch cfg.Tiebreak {
+	case "", TiebreakHull, TiebreakDamage:
+	default:
+		return fmt.Errorf("unknown tiebreak %q", cfg.Tiebreak)
+	}
+
+	ids := make(map[string]bool)
+	for i, o := range cfg.Obstacles {
+		if err := validateCircle(cfg, "obstacle", o.Id, o.Position, o.Radius); err != nil {
+			return err
+		}
+		if o.Id != "" {
+			if ids[o.Id] {
+				return fmt.Errorf("duplicate obstacle id %q", o.Id)
+			}
+			ids[o.Id] = true
+		}
+		for _, other := range cfg.Obstacles[:i] {
+			if circlesOverlap(o.Position, o.Radius, other.Position, other.Radius) {
+				return fmt.Errorf("obstacle %q overlaps obstacle %q", o.Id, other.Id)
+			}
+		}
+	}
+
+	ids = make(map[string]bool)
+	for i, z := range cfg.Zones {
+		if err := validateCircle(cfg, "zone", z.Id, z.Position, z.Radius); err != nil {
+			return err
+		}
+		// Bots track the zones they occupy by ID
+		if z.Id == "" {
+			return fmt.Errorf("zone %d has no id", i)
+		}
+		if ids[z.Id] {
+			return fmt.Errorf("duplicate zone id %q", z.Id)
+		}
+		ids[z.Id] = true
+		if _, ok := LookupZoneType(z.Type); !ok {
+			return fmt.Errorf("zone %q has unknown type %q", z.Id, z.Type)
+		}
+		if !finite(z.Intensity) || z.Intensity < 0 {
+			return fmt.Errorf("zone %q intensity must not be negative", z.Id)
+		}
+		for _, other := range cfg.Zones[:i] {
+			if circlesOverlap(z.Position, z.Radius, other.Position, other.Radius) {
+				return fmt.Errorf("zone %q overlaps zone %q", z.Id, other.Id)
+			}
+		}
+	}
+
+	ids = make(map[string]bool)
+	for i, p := range cfg.Pickups {
+		if p.Position == nil {
+			return fmt.Errorf("pickup %q has no position", p.Id)
+		}
+		if !finite(p.Position.X, p.Position.Y) || p.Position.X < 0 || p.Position.X > cfg.Width || p.Position.Y < 0 || p.Position.Y > cfg.Height {
+			return fmt.Errorf("pickup %q is outside the arena", p.Id)
+		}
+		// Pickup state is matched to its spawn by ID
+		if p.Id == "" {
+			return fmt.Errorf("pickup %d has no id", i)
+		}
+		if ids[p.Id] {
+			return fmt.Errorf("duplicate pickup id %q", p.Id)
+		}
+		ids[p.Id] = true
+		if _, ok := LookupPickupType(p.Type); !ok {
+			return fmt.Errorf("pickup %q has unknown type %q", p.Id, p.Type)
+		}
+		if p.Amount < 0 || p.RespawnTicks < 0 {
+			return fmt.Errorf("pickup %q amount and respawn_ticks must not be negative", p.Id)
+		}
+	}
+
+	for i, p := range cfg.SpawnPoints {
+		if p.Position == nil {
+			return fmt.Errorf("spawn point %d has no position", i)
+		}
+		if !finite(p.Position.X, p.Position.Y) || p.Position.X < 0 || p.Position.X > cfg.Width || p.Position.Y < 0 || p.Position.Y > cfg.Height {
+			return fmt.Errorf("spawn point %d is outside the arena", i)
+		}
+		for _, o := range cfg.Obstacles {
+			if circlesOverlap(p.Position, 0, o.Position, o.Radius) {
+				return fmt.Errorf("spawn point %d is inside obstacle %q", i, o.Id)
+			}
+		}
+	}
+
+	for i, b := range cfg.BuiltinBots {
+		if b.Ai == "" {
+			return fmt.Errorf("builtin bot %d has no AI", i)
+		}
+		if _, ok := LookupController(b.Ai); !ok {
+			return fmt.Errorf("builtin bot %d has unknown AI %q", i, b.Ai)
+		}
+	}
+
+	if schedule := cfg.ZoneSchedule; schedule != nil {
+		if schedule.Center != nil {
+			if c := schedule.Center; !finite(c.X, c.Y) || c.X < 0 || c.X > cfg.Width || c.Y < 0 || c.Y > cfg.Height {
+				return fmt.Errorf("zone schedule center is outside the arena")
+			}
+		}
+		if !finite(schedule.StartRadius) || schedule.StartRadius < 0 {
+			return fmt.Errorf("zone schedule start_radius must not be negative")
+		}
+		radius := schedule.StartRadius
+		for i, p := range schedule.Phases {
+			if p.WaitTicks < 0 || p.ShrinkTicks < 0 {
+				return fmt.Errorf("zone phase %d durations must not be negative", i)
+			}
+			if !finite(p.TargetRadius, p.MaxDrift, p.Damage) {
+				return fmt.Errorf("zone phase %d values must be finite", i)
+			}
+			if p.TargetRadius <= 0 {
+				return fmt.Errorf("zone phase %d target_radius must be positive", i)
+			}
+			if radius > 0 && p.TargetRadius > radius {
+				return fmt.Errorf("zone phase %d target_radius %g is larger than the previous %g", i, p.TargetRadius, radius)
+			}
+			if p.MaxDrift < 0 || p.Damage < 0 {
+				return fmt.Errorf("zone phase %d max_drift and damage must not be negative", i)
+			}
+			radius = p.TargetRadius
+		}
+	}
+
+	return nil
+}
+
+func validateCircle(cfg *pb.ArenaConfig, kind, id string, pos *pb.Vector3, radius float32) error {
+	if pos == nil {
+		return fmt.Errorf("%s %q has no position", kind, id)
+	}
+	if !finite(pos.X, pos.Y, radius) {
+		return fmt.Errorf("%s %q position and radius must be finite", kind, id)
+	}
+	if radius <= 0 {
+		return fmt.Errorf("%s %q radius must be positive", kind, id)
+	}
+	if pos.X < 0 || pos.X > cfg.Width || pos.Y < 0 || pos.Y > cfg.Height {
+		return fmt.Errorf("%s %q is outside the arena", kind, id)
+	}
+	return nil
+}
+
+// finite reports whether none of the values is NaN or infinite.
+func finite(values ...float32) bool {
+	for _, v := range values {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func circlesOverlap(a *pb.Vector3, ra float32, b *pb.Vector3, rb float32) bool {
+	dx := float64(a.X - b.X)
+	dy := float64(a.Y - b.Y)
+	return math.Sqrt(dx*dx+dy*dy) < float64(ra+rb)
+}
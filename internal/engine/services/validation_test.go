@@ -0,0 +1,172 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestValidateArenaConfig(t *testing.T) {
+	nan, inf := float32(math.NaN()), float32(math.Inf(1))
+	tests := []struct {
+		name    string
+		cfg     *pb.ArenaConfig
+		wantErr bool
+	}{
+		{
+			name: "Valid arena",
+			cfg: &pb.ArenaConfig{
+				Width: 800, Height: 600, MaxBots: 4, MatchDurationTicks: 3600,
+				Obstacles: []*pb.Obstacle{{Id: "rock", Position: &pb.Vector3{X: 400, Y: 300}, Radius: 30}},
+				Zones:     []*pb.Zone{{Id: "heal", Position: &pb.Vector3{X: 100, Y: 100}, Radius: 40, Type: "HEAL"}},
+			},
+		},
+		{
+			name:    "Too small",
+			cfg:     &pb.ArenaConfig{Width: 10, Height: 600},
+			wantErr: true,
+		},
+		{
+			name:    "NaN width",
+			cfg:     &pb.ArenaConfig{Width: nan, Height: 600},
+			wantErr: true,
+		},
+		{
+			name: "Infinite obstacle position",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Obstacles: []*pb.Obstacle{
+				{Id: "rock", Position: &pb.Vector3{X: inf, Y: 100}, Radius: 20},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "NaN zone radius",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+				{Id: "heal", Position: &pb.Vector3{X: 100, Y: 100}, Radius: nan, Type: "HEAL"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "NaN spawn point",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, SpawnPoints: []*pb.SpawnPoint{
+				{Position: &pb.Vector3{X: 100, Y: nan}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "Negative max bots",
+			cfg:     &pb.ArenaConfig{Width: 800, Height: 600, MaxBots: -1},
+			wantErr: true,
+		},
+		{
+			name: "Unknown pickup type",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Pickups: []*pb.PickupSpawn{
+				{Id: "crate", Position: &pb.Vector3{X: 100, Y: 100}, Type: "GOLD"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Duplicate pickup id",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Pickups: []*pb.PickupSpawn{
+				{Id: "crate", Position: &pb.Vector3{X: 100, Y: 100}, Type: PickupHealth},
+				{Id: "crate", Position: &pb.Vector3{X: 200, Y: 100}, Type: PickupAmmo},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown deadline policy",
+			cfg:     &pb.ArenaConfig{Width: 800, Height: 600, IntentDeadline: &pb.IntentDeadline{Ticks: 2, OnMiss: "WAIT"}},
+			wantErr: true,
+		},
+		{
+			name: "Unknown builtin AI",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, BuiltinBots: []*pb.BuiltinBot{
+				{Ai: "no-such-ai"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Spawn point inside obstacle",
+			cfg: &pb.ArenaConfig{
+				Width: 800, Height: 600,
+				Obstacles:   []*pb.Obstacle{{Id: "rock", Position: &pb.Vector3{X: 400, Y: 300}, Radius: 30}},
+				SpawnPoints: []*pb.SpawnPoint{{Position: &pb.Vector3{X: 410, Y: 300}}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown class set",
+			cfg:     &pb.ArenaConfig{Width: 800, Height: 600, ClassSet: "no-such-set"},
+			wantErr: true,
+		},
+		{
+			name: "Growing zone phase",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, ZoneSchedule: &pb.ZoneSchedule{
+				StartRadius: 300,
+				Phases:      []*pb.ZonePhase{{TargetRadius: 200}, {TargetRadius: 250}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "Negative duration",
+			cfg:     &pb.ArenaConfig{Width: 800, Height: 600, MatchDurationTicks: -5},
+			wantErr: true,
+		},
+		{
+			name:    "Score target without target",
+			cfg:     &pb.ArenaConfig{Width: 800, Height: 600, WinCondition: WinScoreTarget},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown tiebreak",
+			cfg:     &pb.ArenaConfig{Width: 800, Height: 600, Tiebreak: "COINFLIP"},
+			wantErr: true,
+		},
+		{
+			name: "Overlapping obstacles",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Obstacles: []*pb.Obstacle{
+				{Id: "a", Position: &pb.Vector3{X: 400, Y: 300}, Radius: 30},
+				{Id: "b", Position: &pb.Vector3{X: 420, Y: 300}, Radius: 30},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Overlapping zones",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+				{Id: "a", Position: &pb.Vector3{X: 100, Y: 100}, Radius: 50, Type: "HEAL"},
+				{Id: "b", Position: &pb.Vector3{X: 150, Y: 100}, Radius: 50, Type: "HAZARD"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Obstacle outside arena",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Obstacles: []*pb.Obstacle{
+				{Id: "a", Position: &pb.Vector3{X: 900, Y: 300}, Radius: 30},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Zone without id",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+				{Position: &pb.Vector3{X: 100, Y: 100}, Radius: 50, Type: "HEAL"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Unknown zone type",
+			cfg: &pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+				{Id: "a", Position: &pb.Vector3{X: 100, Y: 100}, Radius: 50, Type: "LAVA"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArenaConfig(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// Win condition and tiebreak identifiers accepted in ArenaConfig
+const (
+	WinLastBotStanding  = "LAST_BOT_STANDING"
+	WinLastTeamStanding = "LAST_TEAM_STANDING"
+	WinScoreTarget      = "SCORE_TARGET"
+
+	TiebreakHull   = "HULL"
+	TiebreakDamage = "DAMAGE"
+
+	// Matches nobody joined are closed after this many ticks
+	IdleMatchTimeoutTicks = 1000
+)
+
+// BotStats accumulates what a bot did during a match, including after it died.
+type BotStats struct {
+	BotID       string
+	Name        string
+	TeamID      string
+	DamageDealt float32
+	Kills       int
+	Deaths      int
+
+	// Response times of bots that play over the network
+	OnTime       int           // Turns played with an intent in time
+	SkippedTurns int           // Turns without an intent in time
+	StaleIntents int           // Intents that arrived after the deadline
+	Latency      time.Duration // Total time from a state to the intents answering it
+	Responses    int           // Intents counted in Latency
+}
+
+// MeanLatency returns the average time the bot took to answer a state.
+func (s BotStats) MeanLatency() time.Duration {
+	if s.Responses == 0 {
+		return 0
+	}
+	return s.Latency / time.Duration(s.Responses)
+}
+
+// MatchResult describes how a match ended.
+type MatchResult struct {
+	WinnerID string   // Winning bot or team, empty on a draw
+	Winners  []string // Bot IDs credited with the win
+}
+
+// WinCondition decides whether a match is over. Evaluate is called once per
+// tick with the engine lock held and must not modify the engine.
+type WinCondition interface {
+	Evaluate(e *SimulationEngine) (*MatchResult, bool)
+}
+
+// NewWinConditions builds the win conditions configured for an arena. The time
+// limit applies on top of the primary condition when match_duration_ticks is set.
+// In team mode every condition counts teams instead of bots.
+func NewWinConditions(cfg *pb.ArenaConfig) []WinCondition {
+	teams := teamPlay(cfg)
+
+	var conditions []WinCondition
+	if cfg.WinCondition == WinScoreTarget {
+		conditions = append(conditions, ScoreTarget{Target: int(cfg.ScoreTarget), Teams: teams})
+	}
+	conditions = append(conditions, LastSideStanding{Teams: teams})
+
+	if cfg.MatchDurationTicks > 0 {
+		conditions = append(conditions, TimeLimit{Ticks: cfg.MatchDurationTicks, Tiebreak: cfg.Tiebreak, Teams: teams})
+	}
+	return conditions
+}
+
+// teamPlay reports whether an arena's matches are won by teams.
+func teamPlay(cfg *pb.ArenaConfig) bool {
+	return cfg.TeamMode || cfg.WinCondition == WinLastTeamStanding
+}
+
+// LastSideStanding ends the match once at most one bot (or team) is left alive.
+type LastSideStanding struct {
+	Teams bool
+}
+
+func (c LastSideStanding) Evaluate(e *SimulationEngine) (*MatchResult, bool) {
+	if len(e.Stats) == 0 {
+		if e.CurrentTick > IdleMatchTimeoutTicks {
+			return &MatchResult{}, true
+		}
+		return nil, false
+	}
+
+	sides := make(map[string]bool)
+	for _, st := range e.Stats {
+		sides[st.side(c.Teams)] = true
+	}
+	alive := make(map[string]bool)
+	for id := range e.Bots {
+		if st, ok := e.Stats[id]; ok {
+			alive[st.side(c.Teams)] = true
+		}
+	}
+
+	switch {
+	case len(alive) == 0:
+		return &MatchResult{}, true
+	case len(alive) == 1 && len(sides) > 1:
+		for side := range alive {
+			return e.resultFor(side, c.Teams), true
+		}
+	}
+	return nil, false
+}
+
+// ScoreTarget ends the match once a bot (or team) reaches the target number of kills.
+type ScoreTarget struct {
+	Target int
+	Teams  bool
+}
+
+func (c ScoreTarget) Evaluate(e *SimulationEngine) (*MatchResult, bool) {
+	if c.Target <= 0 {
+		return nil, false
+	}
+	kills := make(map[string]int)
+	for _, id := range e.sortedStatIDs() {
+		side := e.Stats[id].side(c.Teams)
+		kills[side] += e.Stats[id].Kills
+		if kills[side] >= c.Target {
+			return e.resultFor(side, c.Teams), true
+		}
+	}
+	return nil, false
+}
+
+// TimeLimit ends the match after a fixed number of ticks. The winner is the
+// side with the most remaining hull among the living, or the most damage dealt.
+type TimeLimit struct {
+	Ticks    int64
+	Tiebreak string
+	Teams    bool
+}
+
+func (c TimeLimit) Evaluate(e *SimulationEngine) (*MatchResult, bool) {
+	if c.Ticks <= 0 || e.CurrentTick < c.Ticks {
+		return nil, false
+	}
+	return e.tiebreakResult(c.Tiebreak, c.Teams), true
+}
+
+// tiebreakResult decides a match that ended without a winner by ranking the
+// sides by the given tiebreak; a tie is a draw.
+func (e *SimulationEngine) tiebreakResult(tiebreak string, teams bool) *MatchResult {
+	scores := make(map[string]float32)
+	for _, id := range e.sortedStatIDs() {
+		st := e.Stats[id]
+		switch tiebreak {
+		case TiebreakDamage:
+			scores[st.side(teams)] += st.DamageDealt
+		default:
+			if bot, alive := e.Bots[id]; alive {
+				scores[st.side(teams)] += bot.Hull
+			}
+		}
+	}
+
+	best, bestScore, tied := "", float32(0), false
+	for side, score := range scores {
+		switch {
+		case score > bestScore:
+			best, bestScore, tied = side, score, false
+		case score == bestScore && score > 0:
+			tied = true
+		}
+	}
+	if best == "" || tied {
+		return &MatchResult{}
+	}
+	return e.resultFor(best, teams)
+}
+
+// side is the unit that wins or loses: the team in team play, otherwise the bot.
+func (st *BotStats) side(teams bool) string {
+	if teams && st.TeamID != "" {
+		return st.TeamID
+	}
+	return st.BotID
+}
+
+func (e *SimulationEngine) resultFor(side string, teams bool) *MatchResult {
+	result := &MatchResult{WinnerID: side}
+	for _, id := range e.sortedStatIDs() {
+		if e.Stats[id].side(teams) == side {
+			result.Winners = append(result.Winners, id)
+		}
+	}
+	return result
+}
+
+func (e *SimulationEngine) sortedStatIDs() []string {
+	ids := make([]string, 0, len(e.Stats))
+	for id := range e.Stats {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// getTeamStatsInternal aggregates bot stats per team, sorted by team ID.
+func (e *SimulationEngine) getTeamStatsInternal() []*pb.TeamStats {
+	teams := make(map[string]*pb.TeamStats)
+	ids := make([]string, 0)
+	for _, id := range e.sortedStatIDs() {
+		st := e.Stats[id]
+		if st.TeamID == "" {
+			continue
+		}
+		ts, ok := teams[st.TeamID]
+		if !ok {
+			ts = &pb.TeamStats{TeamId: st.TeamID}
+			teams[st.TeamID] = ts
+			ids = append(ids, st.TeamID)
+		}
+		ts.Kills += int32(st.Kills)
+		ts.Deaths += int32(st.Deaths)
+		ts.DamageDealt += st.DamageDealt
+		if _, alive := e.Bots[id]; alive {
+			ts.Survivors++
+		}
+	}
+
+	sort.Strings(ids)
+	stats := make([]*pb.TeamStats, 0, len(ids))
+	for _, id := range ids {
+		stats = append(stats, teams[id])
+	}
+	return stats
+}
+
+// GetTeamStats returns the per-team totals of the match so far.
+func (e *SimulationEngine) GetTeamStats() []*pb.TeamStats {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.getTeamStatsInternal()
+}
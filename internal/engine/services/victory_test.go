@@ -0,0 +1,111 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func finishedEvent(state *pb.WorldState) *pb.MatchFinishedEvent {
+	for _, ev := range state.Events {
+		if f := ev.GetMatchFinished(); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+func TestWinCondition_LastBotStanding(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 500, Y: 300}, Hull: 1})
+
+	// Lethal bullet from bot1 already overlapping bot2
+	e.Bullets = []*pb.BulletState{
+		{Id: "b1", OwnerId: "bot1", Position: &pb.Vector3{X: 500, Y: 300}, Velocity: 0, Power: 5},
+	}
+
+	state := e.Tick()
+
+	if e.Status != pb.MatchStatus_FINISHED {
+		t.Fatalf("Expected match to finish, got %s", e.Status)
+	}
+	finished := finishedEvent(state)
+	if finished == nil || finished.WinnerId != "bot1" {
+		t.Errorf("Expected bot1 to win, got %v", finished)
+	}
+	if e.Stats["bot1"].DamageDealt != 5 || e.Stats["bot2"].Deaths != 1 {
+		t.Errorf("Unexpected stats: bot1=%+v bot2=%+v", e.Stats["bot1"], e.Stats["bot2"])
+	}
+}
+
+func TestWinCondition_TimeLimitTiebreak(t *testing.T) {
+	tests := []struct {
+		name     string
+		tiebreak string
+		expected string
+	}{
+		{name: "Hull", tiebreak: TiebreakHull, expected: "bot2"},
+		{name: "Damage", tiebreak: TiebreakDamage, expected: "bot1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewSimulationEngine(800, 600, nil)
+			e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, MatchDurationTicks: 1, Tiebreak: tt.tiebreak})
+			e.Status = pb.MatchStatus_RUNNING
+			e.SetBot("bot1", &pb.BotState{Id: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 50})
+			e.SetBot("bot2", &pb.BotState{Id: "bot2", Position: &pb.Vector3{X: 500, Y: 300}, Hull: 80})
+			e.Stats["bot1"].DamageDealt = 40
+
+			state := e.Tick()
+
+			finished := finishedEvent(state)
+			if finished == nil || finished.WinnerId != tt.expected {
+				t.Errorf("Expected %s to win, got %v", tt.expected, finished)
+			}
+		})
+	}
+}
+
+func TestWinCondition_LastTeamStanding(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, WinCondition: WinLastTeamStanding})
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("bot1", &pb.BotState{Id: "bot1", TeamId: "red", Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	e.SetBot("bot2", &pb.BotState{Id: "bot2", TeamId: "red", Position: &pb.Vector3{X: 700, Y: 100}, Hull: 100})
+
+	// A single team never finishes by elimination
+	if state := e.Tick(); finishedEvent(state) != nil {
+		t.Fatal("Match with one team should not finish")
+	}
+
+	e.SetBot("bot3", &pb.BotState{Id: "bot3", TeamId: "blue", Position: &pb.Vector3{X: 400, Y: 500}, Hull: 1})
+	e.Bullets = []*pb.BulletState{
+		{Id: "b1", OwnerId: "bot1", Position: &pb.Vector3{X: 400, Y: 500}, Velocity: 0, Power: 5},
+	}
+
+	state := e.Tick()
+	finished := finishedEvent(state)
+	if finished == nil || finished.WinnerId != "red" {
+		t.Fatalf("Expected team red to win, got %v", finished)
+	}
+}
+
+func TestWinCondition_ScoreTarget(t *testing.T) {
+	e := NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, WinCondition: WinScoreTarget, ScoreTarget: 2})
+	e.Status = pb.MatchStatus_RUNNING
+	for _, id := range []string{"bot1", "bot2", "bot3"} {
+		e.SetBot(id, &pb.BotState{Id: id, Position: &pb.Vector3{X: 100, Y: 100}, Hull: 100})
+	}
+
+	e.Stats["bot2"].Kills = 2
+
+	state := e.Tick()
+	finished := finishedEvent(state)
+	if finished == nil || finished.WinnerId != "bot2" {
+		t.Errorf("Expected bot2 to reach the score target, got %v", finished)
+	}
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// WeaponDef describes how a projectile kind flies and what firing it costs.
+// Velocity, damage, heat and energy scale with the fire power of the shot.
+type WeaponDef struct {
+	Velocity         float32 // Speed at zero fire power
+	VelocityPerPower float32 // Speed change per point of fire power
+	DamagePerPower   float32 // Hull damage per point of fire power
+	Heat             float32 // Gun heat added per shot
+	HeatPerPower     float32 // Extra gun heat per point of fire power
+	EnergyPerPower   float32 // Energy drained per point of fire power
+	TurnRate         float32 // Degrees per tick a homing projectile can turn; 0 flies straight
+	SeekRange        float32 // How far a homing projectile looks for targets
+	TriggerRadius    float32 // Added to the target's radius for proximity hits
+	Lifetime         int32   // Ticks before the projectile expires; 0 = until it hits something
+}
+
+// Speed returns the projectile speed for a shot of the given power.
+func (w WeaponDef) Speed(power float32) float32 {
+	return float32(math.Max(0, float64(w.Velocity+w.VelocityPerPower*power)))
+}
+
+// Damage returns the hull damage of a shot of the given power.
+func (w WeaponDef) Damage(power float32) float32 {
+	return w.DamagePerPower * power
+}
+
+// HeatCost returns the gun heat a shot of the given power adds.
+func (w WeaponDef) HeatCost(power float32) float32 {
+	return w.Heat + w.HeatPerPower*power
+}
+
+// EnergyCost returns the energy a shot of the given power drains.
+func (w WeaponDef) EnergyCost(power float32) float32 {
+	return w.EnergyPerPower * power
+}
+
+var (
+	weaponsMu sync.RWMutex
+	weapons   = map[pb.WeaponType]WeaponDef{
+		pb.WeaponType_SHELL: {
+			Velocity: 20, DamagePerPower: 1,
+			Heat: 1, HeatPerPower: 0.2, EnergyPerPower: 1,
+		},
+		pb.WeaponType_LASER: {
+			Velocity: 40, VelocityPerPower: 2, DamagePerPower: 0.5,
+			Heat: 0.5, HeatPerPower: 0.1, EnergyPerPower: 0.5,
+			Lifetime: 60,
+		},
+		pb.WeaponType_MISSILE: {
+			Velocity: 12, VelocityPerPower: -1, DamagePerPower: 1.5,
+			Heat: 2, HeatPerPower: 0.5, EnergyPerPower: 2,
+			TurnRate: 6, SeekRange: 400, Lifetime: 150,
+		},
+		pb.WeaponType_MINE: {
+			Velocity: 0, DamagePerPower: 2,
+			Heat: 1.5, HeatPerPower: 0.2, EnergyPerPower: 1.5,
+			TriggerRadius: 20, Lifetime: 600,
+		},
+	}
+)
+
+// RegisterWeapon adds or replaces the stats of a projectile kind.
+func RegisterWeapon(weapon pb.WeaponType, def WeaponDef) {
+	weaponsMu.Lock()
+	defer weaponsMu.Unlock()
+	weapons[weapon] = def
+}
+
+// LookupWeapon returns the stats of a projectile kind.
+func LookupWeapon(weapon pb.WeaponType) (WeaponDef, bool) {
+	weaponsMu.RLock()
+	defer weaponsMu.RUnlock()
+	def, ok := weapons[weapon]
+	return def, ok
+}
+
+// ParseWeapon resolves a weapon name as written in class files, e.g. "LASER".
+func ParseWeapon(name string) (pb.WeaponType, error) {
+	v, ok := pb.WeaponType_value[name]
+	if !ok {
+		return pb.WeaponType_SHELL, fmt.Errorf("unknown weapon %q", name)
+	}
+	return pb.WeaponType(v), nil
+}
+
+// fire launches a projectile from robot if the intent asks for one that the
+// class carries and the gun is cool and charged.
+func (pe *PhysicsEngine) fire(robot *pb.BotState, intent *pb.BotIntent, class *ClassDef, newState *pb.WorldState) {
+	if intent == nil || intent.FirePower <= 0 || robot.Heat > 0 || !class.CanFire(intent.Weapon) {
+		return
+	}
+	def, ok := LookupWeapon(intent.Weapon)
+	if !ok || robot.Energy < def.EnergyCost(intent.FirePower) {
+		return
+	}
+
+	newState.Bullets = append(newState.Bullets, &pb.BulletState{
+		Id:       fmt.Sprintf("bullet_%s_%d", robot.Id, newState.Tick),
+		OwnerId:  robot.Id,
+		Position: &pb.Vector3{X: robot.Position.X, Y: robot.Position.Y, Z: 0},
+		Heading:  robot.GunHeading,
+		Velocity: def.Speed(intent.FirePower),
+		Power:    intent.FirePower,
+		TeamId:   robot.TeamId,
+		Weapon:   intent.Weapon,
+		Ttl:      def.Lifetime,
+	})
+	robot.Heat += def.HeatCost(intent.FirePower)
+	robot.Energy -= def.EnergyCost(intent.FirePower)
+}
+
+// steer turns a homing projectile towards the nearest enemy within its seek
+// range, by at most its turn rate. Robots are visited in order so runs agree.
+func (pe *PhysicsEngine) steer(bullet *pb.BulletState, def WeaponDef, robots map[string]*pb.BotState, order []string, arena *pb.ArenaConfig) float32 {
+	if def.TurnRate <= 0 {
+		return bullet.Heading
+	}
+
+	var target *pb.BotState
+	best := float64(def.SeekRange)
+	for _, id := range order {
+		robot, alive := robots[id]
+		if !alive || robot.Id == bullet.OwnerId {
+			continue
+		}
+		if !arena.FriendlyFire && sameTeam(bullet.TeamId, robot.TeamId) {
+			continue
+		}
+		dist := math.Hypot(float64(robot.Position.X-bullet.Position.X), float64(robot.Position.Y-bullet.Position.Y))
+		if dist <= best {
+			target, best = robot, dist
+		}
+	}
+	if target == nil {
+		return bullet.Heading
+	}
+
+	bearing := math.Atan2(float64(target.Position.X-bullet.Position.X), float64(bullet.Position.Y-target.Position.Y)) * 180 / math.Pi
+	turn := math.Mod(bearing-float64(bullet.Heading)+540, 360) - 180
+	turn = math.Max(-float64(def.TurnRate), math.Min(float64(def.TurnRate), turn))
+	return pe.normalizeAngle(bullet.Heading + float32(turn))
+}
+
+// weaponOf returns the stats of the projectile kind a bullet was fired as.
+// Unknown kinds fly as shells.
+func weaponOf(bullet *pb.BulletState) WeaponDef {
+	if def, ok := LookupWeapon(bullet.Weapon); ok {
+		return def
+	}
+	def, _ := LookupWeapon(pb.WeaponType_SHELL)
+	return def
+}
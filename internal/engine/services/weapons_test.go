@@ -0,0 +1,109 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func TestPhysicsUpdate_FireWeapon(t *testing.T) {
+	tests := []struct {
+		name     string
+		class    string
+		weapon   pb.WeaponType
+		wantShot bool
+	}{
+		{name: "Shell", class: ClassTank, weapon: pb.WeaponType_SHELL, wantShot: true},
+		{name: "Laser", class: ClassSniper, weapon: pb.WeaponType_LASER, wantShot: true},
+		{name: "Missile", class: ClassTank, weapon: pb.WeaponType_MISSILE, wantShot: true},
+		{name: "Mine", class: ClassScout, weapon: pb.WeaponType_MINE, wantShot: true},
+		{name: "Weapon the class lacks", class: ClassSniper, weapon: pb.WeaponType_MISSILE, wantShot: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pe := NewPhysicsEngine()
+			arena := &pb.ArenaConfig{Width: 800, Height: 600}
+			state := &pb.WorldState{
+				Bots: []*pb.BotState{
+					{Id: "bot1", Class: tt.class, Position: &pb.Vector3{X: 400, Y: 300}, Hull: 100, Energy: 50},
+				},
+			}
+
+			intent := &pb.BotIntent{FirePower: 2, Weapon: tt.weapon}
+			newState := pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": intent})
+
+			if !tt.wantShot {
+				if len(newState.Bullets) != 0 {
+					t.Errorf("Expected no projectile, got %v", newState.Bullets)
+				}
+				return
+			}
+			if len(newState.Bullets) != 1 {
+				t.Fatalf("Expected one projectile, got %d", len(newState.Bullets))
+			}
+
+			def, _ := LookupWeapon(tt.weapon)
+			bullet := newState.Bullets[0]
+			if bullet.Weapon != tt.weapon || bullet.Velocity != def.Speed(2) || bullet.Ttl != def.Lifetime {
+				t.Errorf("Projectile does not match %s: %v", tt.weapon, bullet)
+			}
+			regen := pe.Classes.Get(tt.class).EnergyRegen
+			if got, want := newState.Bots[0].Energy, 50+regen-def.EnergyCost(2); got != want {
+				t.Errorf("Energy = %f, want %f", got, want)
+			}
+		})
+	}
+}
+
+func TestPhysicsUpdate_MissileHomes(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "target", Position: &pb.Vector3{X: 500, Y: 200}, Hull: 100},
+		},
+		Bullets: []*pb.BulletState{
+			{Id: "m1", OwnerId: "bot1", Position: &pb.Vector3{X: 300, Y: 200}, Heading: 0, Velocity: 10, Power: 1, Weapon: pb.WeaponType_MISSILE, Ttl: 100},
+		},
+	}
+
+	newState := pe.Update(state, arena, nil)
+
+	if len(newState.Bullets) != 1 {
+		t.Fatalf("Expected the missile to keep flying, got %d", len(newState.Bullets))
+	}
+	missile := newState.Bullets[0]
+	def, _ := LookupWeapon(pb.WeaponType_MISSILE)
+	if missile.Heading != def.TurnRate {
+		t.Errorf("Missile should turn east by its turn rate, heading=%f", missile.Heading)
+	}
+	if missile.Ttl != 99 {
+		t.Errorf("Expected ttl to count down to 99, got %d", missile.Ttl)
+	}
+}
+
+func TestPhysicsUpdate_MineTriggersAndExpires(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600}
+	def, _ := LookupWeapon(pb.WeaponType_MINE)
+
+	state := &pb.WorldState{
+		Bots: []*pb.BotState{
+			{Id: "bot2", Position: &pb.Vector3{X: 400 + RobotRadius + def.TriggerRadius - 5, Y: 300}, Hull: 100},
+		},
+		Bullets: []*pb.BulletState{
+			{Id: "mine", OwnerId: "bot1", Position: &pb.Vector3{X: 400, Y: 300}, Power: 2, Weapon: pb.WeaponType_MINE, Ttl: 50},
+			{Id: "old", OwnerId: "bot1", Position: &pb.Vector3{X: 100, Y: 100}, Power: 2, Weapon: pb.WeaponType_MINE, Ttl: 1},
+		},
+	}
+
+	newState := pe.Update(state, arena, nil)
+
+	if len(newState.Bullets) != 0 {
+		t.Errorf("Expected the mine to go off and the old one to expire, got %v", newState.Bullets)
+	}
+	if got, want := newState.Bots[0].Hull, 100-def.Damage(2); got != want {
+		t.Errorf("Hull = %f, want %f", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"math"
+	"math/rand"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewZoneState builds the opening safe zone of an arena's zone schedule, or
+// nil when the arena has none.
+func NewZoneState(cfg *pb.ArenaConfig) *pb.ZoneState {
+	schedule := cfg.ZoneSchedule
+	if schedule == nil || len(schedule.Phases) == 0 {
+		return nil
+	}
+
+	zone := &pb.ZoneState{
+		X:      cfg.Width / 2,
+		Y:      cfg.Height / 2,
+		Radius: schedule.StartRadius,
+	}
+	if schedule.Center != nil {
+		zone.X, zone.Y = schedule.Center.X, schedule.Center.Y
+	}
+	if zone.Radius <= 0 {
+		// Reach every corner of the arena
+		zone.Radius = float32(math.Hypot(float64(cfg.Width), float64(cfg.Height)))
+	}
+	enterZonePhase(zone, 0, cfg)
+	return zone
+}
+
+// advanceZone moves the safe zone one tick along its schedule. It depends only
+// on the zone and the arena, so replays and keyframes reproduce it exactly.
+func advanceZone(zone *pb.ZoneState, cfg *pb.ArenaConfig) *pb.ZoneState {
+	next := proto.Clone(zone).(*pb.ZoneState)
+	phases := cfg.GetZoneSchedule().GetPhases()
+	if int(next.Phase) >= len(phases) {
+		return next
+	}
+
+	// Close an even share of the remaining gap each tick
+	if next.Shrinking && next.TicksRemaining > 0 {
+		f := 1 / float32(next.TicksRemaining)
+		next.X += (next.TargetX - next.X) * f
+		next.Y += (next.TargetY - next.Y) * f
+		next.Radius += (next.TargetRadius - next.Radius) * f
+	}
+	next.TicksRemaining--
+
+	// Zero-length holds and shrinks end straight away
+	for next.TicksRemaining <= 0 && int(next.Phase) < len(phases) {
+		if !next.Shrinking {
+			next.Shrinking = true
+			next.TicksRemaining = phases[next.Phase].ShrinkTicks
+			continue
+		}
+		next.X, next.Y, next.Radius = next.TargetX, next.TargetY, next.TargetRadius
+		enterZonePhase(next, next.Phase+1, cfg)
+	}
+	return next
+}
+
+// enterZonePhase starts holding before a phase and picks the circle it shrinks
+// to. Past the last phase, the zone stays put with the last phase's damage.
+func enterZonePhase(zone *pb.ZoneState, phase int32, cfg *pb.ArenaConfig) {
+	phases := cfg.ZoneSchedule.Phases
+	zone.Phase = phase
+	zone.Shrinking = false
+	if int(phase) >= len(phases) {
+		zone.TicksRemaining = 0
+		zone.TargetX, zone.TargetY, zone.TargetRadius = zone.X, zone.Y, zone.Radius
+		return
+	}
+
+	p := phases[phase]
+	zone.TicksRemaining = p.WaitTicks
+	zone.Damage = p.Damage
+	zone.TargetRadius = p.TargetRadius
+
+	// Drift the center, keeping the target circle inside the current one
+	drift := math.Min(float64(p.MaxDrift), math.Max(0, float64(zone.Radius-p.TargetRadius)))
+	rng := rand.New(rand.NewSource(cfg.Seed + int64(phase)))
+	angle := rng.Float64() * 2 * math.Pi
+	dist := rng.Float64() * drift
+	zone.TargetX = clamp(zone.X+float32(math.Cos(angle)*dist), 0, cfg.Width)
+	zone.TargetY = clamp(zone.Y+float32(math.Sin(angle)*dist), 0, cfg.Height)
+}
+
+func clamp(v, lo, hi float32) float32 {
+	return float32(math.Max(float64(lo), math.Min(float64(hi), float64(v))))
+}
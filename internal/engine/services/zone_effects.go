@@ -0,0 +1,142 @@
+package services
+
+import (
+	"math"
+	"sync"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// Built-in arena zone types
+const (
+	ZoneHeal       = "HEAL"
+	ZoneEnergy     = "ENERGY"
+	ZoneHazard     = "HAZARD"
+	ZoneSpeedBoost = "SPEED_BOOST"
+	ZoneSlow       = "SLOW"
+	ZoneRadarJam   = "RADAR_JAM"
+)
+
+// ZoneEffect describes what an arena zone type does to the robots inside it.
+// Amounts are per tick at intensity 1 and scale with the zone's intensity.
+type ZoneEffect struct {
+	Heal        float32 // Hull restored, up to the class maximum
+	Energy      float32 // Energy restored, up to the class maximum
+	Damage      float32 // Hull damage, credited to "zone:<id>"
+	SpeedFactor float32 // Multiplies max velocity; 0 leaves it unchanged
+	JamsRadar   bool    // Robots inside cannot scan or see by radar
+}
+
+var (
+	zoneEffectsMu sync.RWMutex
+	zoneEffects   = map[string]ZoneEffect{
+		ZoneHeal:       {Heal: HealZoneAmount},
+		ZoneEnergy:     {Energy: EnergyZoneAmount},
+		ZoneHazard:     {Damage: HazardZoneDamage},
+		ZoneSpeedBoost: {SpeedFactor: SpeedBoostZoneFactor},
+		ZoneSlow:       {SpeedFactor: SlowZoneFactor},
+		ZoneRadarJam:   {JamsRadar: true},
+	}
+)
+
+// RegisterZoneType adds or replaces an arena zone type.
+func RegisterZoneType(zoneType string, effect ZoneEffect) {
+	zoneEffectsMu.Lock()
+	defer zoneEffectsMu.Unlock()
+	zoneEffects[zoneType] = effect
+}
+
+// LookupZoneType returns the effect of an arena zone type.
+func LookupZoneType(zoneType string) (ZoneEffect, bool) {
+	zoneEffectsMu.RLock()
+	defer zoneEffectsMu.RUnlock()
+	effect, ok := zoneEffects[zoneType]
+	return effect, ok
+}
+
+func zoneIntensity(zone *pb.Zone) float32 {
+	if zone.Intensity == 0 {
+		return 1
+	}
+	return zone.Intensity
+}
+
+func insideZone(robot *pb.BotState, zone *pb.Zone) bool {
+	dx := float64(robot.Position.X - zone.Position.X)
+	dy := float64(robot.Position.Y - zone.Position.Y)
+	return math.Sqrt(dx*dx+dy*dy) <= float64(zone.Radius)
+}
+
+// zoneSpeedFactor combines the speed effects of the zones a robot occupies.
+func zoneSpeedFactor(robot *pb.BotState, arena *pb.ArenaConfig) float32 {
+	factor := float32(1)
+	for _, zone := range arena.Zones {
+		if !contains(robot.ZoneIds, zone.Id) {
+			continue
+		}
+		effect, _ := LookupZoneType(zone.Type)
+		if effect.SpeedFactor == 0 {
+			continue
+		}
+		// Intensity scales how far the factor moves speed away from normal
+		factor *= float32(math.Max(0.1, float64(1+(effect.SpeedFactor-1)*zoneIntensity(zone))))
+	}
+	return factor
+}
+
+// updateZoneOccupancy applies the effects of the arena zones a robot is in and
+// emits events for the zones it entered or left this tick.
+func (pe *PhysicsEngine) updateZoneOccupancy(robot *pb.BotState, previous []string, class *ClassDef, arena *pb.ArenaConfig, sources damageSources, newState *pb.WorldState) {
+	robot.ZoneIds = nil
+	robot.RadarJammed = false
+
+	for _, zone := range arena.Zones {
+		effect, _ := LookupZoneType(zone.Type)
+		inside := insideZone(robot, zone)
+		wasInside := contains(previous, zone.Id)
+
+		switch {
+		case inside && !wasInside:
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_ZoneEntered{
+					ZoneEntered: &pb.ZoneEnteredEvent{BotId: robot.Id, ZoneId: zone.Id, Type: zone.Type},
+				},
+			})
+		case !inside && wasInside:
+			newState.Events = append(newState.Events, &pb.SimulationEvent{
+				Tick: newState.Tick,
+				Event: &pb.SimulationEvent_ZoneExited{
+					ZoneExited: &pb.ZoneExitedEvent{BotId: robot.Id, ZoneId: zone.Id, Type: zone.Type},
+				},
+			})
+		}
+		if !inside {
+			continue
+		}
+
+		robot.ZoneIds = append(robot.ZoneIds, zone.Id)
+		intensity := zoneIntensity(zone)
+		if effect.Heal > 0 && robot.Hull < class.MaxHull {
+			robot.Hull = float32(math.Min(float64(class.MaxHull), float64(robot.Hull+effect.Heal*intensity)))
+		}
+		if effect.Energy > 0 && robot.Energy < class.MaxEnergy {
+			robot.Energy = float32(math.Min(float64(class.MaxEnergy), float64(robot.Energy+effect.Energy*intensity)))
+		}
+		if effect.Damage > 0 {
+			pe.applyDamage(robot, effect.Damage*intensity, ZoneDamageSource+":"+zone.Id, sources)
+		}
+		if effect.JamsRadar {
+			robot.RadarJammed = true
+		}
+	}
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func countZoneEvents(events []*pb.SimulationEvent) (entered, exited int) {
+	for _, ev := range events {
+		if ev.GetZoneEntered() != nil {
+			entered++
+		}
+		if ev.GetZoneExited() != nil {
+			exited++
+		}
+	}
+	return entered, exited
+}
+
+func TestZoneOccupancy_EnterAndExitOnce(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+		{Id: "heal", Position: &pb.Vector3{X: 200, Y: 300}, Radius: 50, Type: ZoneHeal},
+	}}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "bot1", Position: &pb.Vector3{X: 200, Y: 300}, Heading: 90, Hull: 50},
+	}}
+
+	var entered, exited int
+	for i := 0; i < 30; i++ {
+		state = pe.Update(state, arena, map[string]*pb.BotIntent{"bot1": {MoveDistance: 100}})
+		e, x := countZoneEvents(state.Events)
+		entered += e
+		exited += x
+	}
+
+	if entered != 1 || exited != 1 {
+		t.Errorf("Expected one enter and one exit event, got %d and %d", entered, exited)
+	}
+	if bot := state.Bots[0]; len(bot.ZoneIds) != 0 {
+		t.Errorf("Bot left the zone but still occupies %v", bot.ZoneIds)
+	}
+}
+
+func TestZoneOccupancy_IntensityScalesEffects(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+		{Id: "heal", Position: &pb.Vector3{X: 200, Y: 300}, Radius: 50, Type: ZoneHeal, Intensity: 10},
+		{Id: "lava", Position: &pb.Vector3{X: 600, Y: 300}, Radius: 50, Type: ZoneHazard, Intensity: 5},
+	}}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "healed", Position: &pb.Vector3{X: 200, Y: 300}, Hull: 50},
+		{Id: "burned", Position: &pb.Vector3{X: 600, Y: 300}, Hull: 50},
+	}}
+
+	state = pe.Update(state, arena, nil)
+
+	hull := map[string]float32{}
+	for _, b := range state.Bots {
+		hull[b.Id] = b.Hull
+	}
+	if want := float32(50 + HealZoneAmount*10); hull["healed"] != want {
+		t.Errorf("Expected heal scaled to %f, got %f", want, hull["healed"])
+	}
+	if want := float32(50 - HazardZoneDamage*5); hull["burned"] != want {
+		t.Errorf("Expected damage scaled to %f, got %f", want, hull["burned"])
+	}
+}
+
+func TestZoneOccupancy_SpeedAndRadarJam(t *testing.T) {
+	pe := NewPhysicsEngine()
+	arena := &pb.ArenaConfig{Width: 2000, Height: 600, Zones: []*pb.Zone{
+		{Id: "boost", Position: &pb.Vector3{X: 200, Y: 300}, Radius: 100, Type: ZoneSpeedBoost},
+		{Id: "jam", Position: &pb.Vector3{X: 1500, Y: 300}, Radius: 100, Type: ZoneRadarJam},
+	}}
+	state := &pb.WorldState{Bots: []*pb.BotState{
+		{Id: "runner", Position: &pb.Vector3{X: 200, Y: 300}, Heading: 90, Hull: 100},
+		{Id: "jammed", Position: &pb.Vector3{X: 1500, Y: 300}, RadarHeading: 270, Hull: 100},
+		{Id: "target", Position: &pb.Vector3{X: 1300, Y: 300}, Hull: 100},
+	}}
+
+	for i := 0; i < 20; i++ {
+		state = pe.Update(state, arena, map[string]*pb.BotIntent{"runner": {MoveDistance: 100}})
+		for _, ev := range state.Events {
+			if scan := ev.GetScannedBot(); scan != nil && scan.ScannerId == "jammed" {
+				t.Fatal("A jammed radar should not scan")
+			}
+		}
+	}
+
+	var runner, jammed *pb.BotState
+	for _, b := range state.Bots {
+		switch b.Id {
+		case "runner":
+			runner = b
+		case "jammed":
+			jammed = b
+		}
+	}
+	tank := pe.Classes.Get(ClassTank)
+	if runner.Velocity <= tank.MaxVelocity {
+		t.Errorf("Expected the boost to lift velocity above %f, got %f", tank.MaxVelocity, runner.Velocity)
+	}
+	if !jammed.RadarJammed {
+		t.Error("Expected the bot in the jamming zone to be flagged")
+	}
+	if filtered := pe.FilterStateForBot("jammed", state); len(filtered.Bots) != 1 {
+		t.Errorf("A jammed bot should only see itself, saw %d bots", len(filtered.Bots))
+	}
+}
+
+func TestRegisterZoneType(t *testing.T) {
+	RegisterZoneType("TEST_DRAIN", ZoneEffect{Damage: 2})
+	if err := ValidateArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Zones: []*pb.Zone{
+		{Id: "drain", Position: &pb.Vector3{X: 100, Y: 100}, Radius: 40, Type: "TEST_DRAIN"},
+	}}); err != nil {
+		t.Errorf("Registered zone type rejected: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func zoneArena(seed int64) *pb.ArenaConfig {
+	return &pb.ArenaConfig{
+		Width:  1000,
+		Height: 1000,
+		Seed:   seed,
+		ZoneSchedule: &pb.ZoneSchedule{
+			StartRadius: 500,
+			Phases: []*pb.ZonePhase{
+				{WaitTicks: 10, ShrinkTicks: 20, TargetRadius: 300, MaxDrift: 150, Damage: 1},
+				{WaitTicks: 5, ShrinkTicks: 10, TargetRadius: 100, MaxDrift: 100, Damage: 3},
+			},
+		},
+	}
+}
+
+func TestZone_FollowsSchedule(t *testing.T) {
+	cfg := zoneArena(42)
+	zone := NewZoneState(cfg)
+	if zone.X != 500 || zone.Y != 500 || zone.Radius != 500 {
+		t.Fatalf("Expected the zone to open at the arena center, got %v", zone)
+	}
+	if zone.Shrinking || zone.TicksRemaining != 10 || zone.Damage != 1 {
+		t.Fatalf("Expected phase 0 to hold for 10 ticks, got %v", zone)
+	}
+
+	target := &pb.ZoneState{X: zone.TargetX, Y: zone.TargetY, Radius: zone.TargetRadius}
+	if d := math.Hypot(float64(target.X-zone.X), float64(target.Y-zone.Y)); d > 150 {
+		t.Errorf("Center drifted %f, more than max_drift", d)
+	}
+
+	for i := 0; i < 10; i++ {
+		zone = advanceZone(zone, cfg)
+	}
+	if !zone.Shrinking || zone.Radius != 500 {
+		t.Fatalf("Expected the shrink to start after the hold, got %v", zone)
+	}
+
+	for i := 0; i < 20; i++ {
+		zone = advanceZone(zone, cfg)
+	}
+	if zone.Phase != 1 || zone.Shrinking || zone.Damage != 3 {
+		t.Fatalf("Expected phase 1 to begin, got %v", zone)
+	}
+	if zone.X != target.X || zone.Y != target.Y || zone.Radius != 300 {
+		t.Errorf("Expected the zone to land on its target %v, got %v", target, zone)
+	}
+
+	for i := 0; i < 15; i++ {
+		zone = advanceZone(zone, cfg)
+	}
+	if int(zone.Phase) != len(cfg.ZoneSchedule.Phases) || zone.Radius != 100 || zone.Damage != 3 {
+		t.Errorf("Expected the final circle with the last phase's damage, got %v", zone)
+	}
+	if final := advanceZone(zone, cfg); final.Radius != 100 || final.X != zone.X {
+		t.Error("The final zone should stay put")
+	}
+}
+
+func TestZone_DriftIsSeeded(t *testing.T) {
+	a, b := NewZoneState(zoneArena(7)), NewZoneState(zoneArena(7))
+	if a.TargetX != b.TargetX || a.TargetY != b.TargetY {
+		t.Error("Same seed should pick the same target")
+	}
+	if c := NewZoneState(zoneArena(8)); c.TargetX == a.TargetX && c.TargetY == a.TargetY {
+		t.Error("Different seeds should pick different targets")
+	}
+}
+
+func TestZone_DamagesOutside(t *testing.T) {
+	e := NewSimulationEngine(1000, 1000, nil)
+	e.SetArenaConfig(zoneArena(1))
+	e.Status = pb.MatchStatus_RUNNING
+	e.SetBot("inside", &pb.BotState{Id: "inside", Position: &pb.Vector3{X: 500, Y: 500}, Hull: 100})
+	e.SetBot("outside", &pb.BotState{Id: "outside", Position: &pb.Vector3{X: 30, Y: 30}, Hull: 100})
+
+	state := e.Tick()
+	if state.Zone == nil || state.Zone.TicksRemaining != 9 {
+		t.Fatalf("Expected the zone countdown in the world state, got %v", state.Zone)
+	}
+	if e.Bots["inside"].Hull != 100 {
+		t.Errorf("Bot inside the zone took damage: %f", e.Bots["inside"].Hull)
+	}
+	if e.Bots["outside"].Hull != 99 {
+		t.Errorf("Expected phase damage outside the zone, hull %f", e.Bots["outside"].Hull)
+	}
+}
@@ -2,6 +2,7 @@ package persistence
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
@@ -21,7 +22,7 @@ func NewDatabase(dbPath string) (*Database, error) {
 	}
 
 	// Auto Migration
-	err = db.AutoMigrate(&Match{}, &Bot{}, &EventLog{})
+	err = db.AutoMigrate(&Match{}, &Bot{}, &EventLog{}, &TickLog{}, &StateFrame{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
@@ -37,10 +38,43 @@ func (d *Database) UpdateMatch(match *Match) error {
 	return d.db.Save(match).Error
 }
 
+// StartMatch marks a match as running, creating its row if the match was
+// never created.
+func (d *Database) StartMatch(match *Match) error {
+	res := d.db.Model(&Match{}).Where("id = ?", match.ID).Update("status", match.Status)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return d.db.Create(match).Error
+	}
+	return nil
+}
+
+// FinishMatch records how a match ended. Only the result columns of an
+// existing row change, so its creation time is kept.
+func (d *Database) FinishMatch(match *Match) error {
+	res := d.db.Model(&Match{}).Where("id = ?", match.ID).
+		Select("status", "winner_id", "arena_width", "arena_height", "seed", "spawns", "finished_at").
+		Updates(match)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return d.db.Create(match).Error
+	}
+	return nil
+}
+
 func (d *Database) UpsertBot(bot *Bot) error {
 	return d.db.Save(bot).Error
 }
 
+// EnsureBot creates the bot row if it does not exist yet, leaving existing stats untouched.
+func (d *Database) EnsureBot(bot *Bot) error {
+	return d.db.Where(Bot{ID: bot.ID}).Attrs(*bot).FirstOrCreate(&Bot{}).Error
+}
+
 func (d *Database) SaveEvent(event *EventLog) error {
 	return d.db.Create(event).Error
 }
@@ -65,6 +99,53 @@ func (d *Database) GetEvents(matchID string, startTick, endTick int64) ([]EventL
 	return events, err
 }
 
+func (d *Database) SaveTickLog(log *TickLog) error {
+	return d.db.Create(log).Error
+}
+
+// GetTickLogs returns the recorded ticks of a match in the order they were saved.
+func (d *Database) GetTickLogs(matchID string) ([]TickLog, error) {
+	var logs []TickLog
+	err := d.db.Where("match_id = ?", matchID).Order("id asc").Find(&logs).Error
+	return logs, err
+}
+
+func (d *Database) SaveStateFrame(frame *StateFrame) error {
+	return d.db.Create(frame).Error
+}
+
+// GetStateFrames returns the frames needed to rebuild ticks startTick..endTick,
+// starting at the last keyframe at or before startTick. When a match ID was
+// run several times, only the most recent run is read.
+func (d *Database) GetStateFrames(matchID string, startTick, endTick int64) ([]StateFrame, error) {
+	// Every run records its first tick, so the latest run starts at the last
+	// frame saved for tick 1
+	var first StateFrame
+	err := d.db.Where("match_id = ? AND tick <= ?", matchID, 1).Order("id desc").Limit(1).Find(&first).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var fromTick int64
+	if startTick > 0 {
+		var keyframe StateFrame
+		err := d.db.Where("match_id = ? AND id >= ? AND keyframe = ? AND tick <= ?", matchID, first.ID, true, startTick).
+			Order("tick desc").Limit(1).Find(&keyframe).Error
+		if err != nil {
+			return nil, err
+		}
+		fromTick = keyframe.Tick
+	}
+
+	var frames []StateFrame
+	query := d.db.Where("match_id = ? AND id >= ? AND tick >= ?", matchID, first.ID, fromTick)
+	if endTick > 0 {
+		query = query.Where("tick <= ?", endTick)
+	}
+	err = query.Order("id asc").Find(&frames).Error
+	return frames, err
+}
+
 func (d *Database) GetHighlights(matchID string) ([]EventLog, error) {
 	var events []EventLog
 	// Filter for "interesting" events: Deaths and Hits
@@ -87,6 +168,19 @@ func (d *Database) RecordBotStats(botID string, kills, deaths int) error {
 		}).Error
 }
 
+// RecordBotResponses adds how a bot kept to its intent deadline in a match to
+// its totals.
+func (d *Database) RecordBotResponses(botID string, onTime, skipped, stale, responses int, latency time.Duration) error {
+	return d.db.Model(&Bot{}).Where("id = ?", botID).
+		Updates(map[string]interface{}{
+			"on_time":       gorm.Expr("on_time + ?", onTime),
+			"skipped_turns": gorm.Expr("skipped_turns + ?", skipped),
+			"stale_intents": gorm.Expr("stale_intents + ?", stale),
+			"responses":     gorm.Expr("responses + ?", responses),
+			"latency_ms":    gorm.Expr("latency_ms + ?", latency.Milliseconds()),
+		}).Error
+}
+
 func (d *Database) ListMatches() ([]Match, error) {
 	var matches []Match
 	err := d.db.Order("created_at desc").Find(&matches).Error
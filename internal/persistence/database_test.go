@@ -3,6 +3,7 @@ package persistence
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 func TestDatabase_ReplayFlow(t *testing.T) {
@@ -81,3 +82,37 @@ func TestDatabase_BotUpsert(t *testing.T) {
 		t.Errorf("Expected 1 win, got %d", saved.Wins)
 	}
 }
+
+func TestDatabase_EnsureBotKeepsStats(t *testing.T) {
+	dbPath := "test_ensure_bot.db"
+	defer os.Remove(dbPath)
+
+	db, _ := NewDatabase(dbPath)
+	defer db.Close()
+
+	db.EnsureBot(&Bot{ID: "bot1", Name: "Alpha"})
+	db.IncrementBotWin("bot1")
+	db.EnsureBot(&Bot{ID: "bot1", Name: "Alpha"})
+	db.RecordBotStats("bot1", 3, 1)
+
+	var saved Bot
+	db.db.First(&saved, "id = ?", "bot1")
+	if saved.Wins != 1 || saved.Kills != 3 || saved.Deaths != 1 {
+		t.Errorf("Expected stats to accumulate, got wins=%d kills=%d deaths=%d", saved.Wins, saved.Kills, saved.Deaths)
+	}
+}
+
+func TestDatabase_RecordBotResponses(t *testing.T) {
+	db, _ := NewDatabase(":memory:")
+	defer db.Close()
+
+	db.EnsureBot(&Bot{ID: "bot1", Name: "Alpha"})
+	db.RecordBotResponses("bot1", 90, 10, 4, 96, 480*time.Millisecond)
+	db.RecordBotResponses("bot1", 10, 0, 0, 10, 20*time.Millisecond)
+
+	var saved Bot
+	db.db.First(&saved, "id = ?", "bot1")
+	if saved.OnTime != 100 || saved.SkippedTurns != 10 || saved.StaleIntents != 4 || saved.Responses != 106 || saved.LatencyMS != 500 {
+		t.Errorf("Expected response stats to accumulate, got %+v", saved)
+	}
+}
@@ -12,6 +12,8 @@ type Match struct {
 	WinnerID    string
 	ArenaWidth  float32
 	ArenaHeight float32
+	Seed        int64
+	Spawns      string // JSON list of where each bot entered the match
 	CreatedAt   time.Time
 	FinishedAt  *time.Time
 	Events      []EventLog `gorm:"foreignKey:MatchID"`
@@ -25,6 +27,13 @@ type Bot struct {
 	Kills     int
 	Deaths    int
 	UpdatedAt time.Time
+
+	// Intent deadline record of networked play, summed over matches
+	OnTime       int
+	SkippedTurns int
+	StaleIntents int
+	Responses    int
+	LatencyMS    int64 // Total time taken to answer the states counted in Responses
 }
 
 type EventLog struct {
@@ -34,3 +43,21 @@ type EventLog struct {
 	Type    string
 	Payload string // JSON
 }
+
+// TickLog stores one recorded simulation step as a serialized TickRecord.
+type TickLog struct {
+	gorm.Model
+	MatchID string `gorm:"index"`
+	Tick    int64
+	Payload []byte // Protobuf
+}
+
+// StateFrame stores the world state after one tick, either in full (a
+// keyframe) or as the changes since the previous tick.
+type StateFrame struct {
+	gorm.Model
+	MatchID  string `gorm:"index"`
+	Tick     int64  `gorm:"index"`
+	Keyframe bool
+	Payload  []byte // Protobuf WorldState for keyframes, WorldStateDelta otherwise
+}
@@ -21,34 +21,30 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type ArenaConfig struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Width              float32                `protobuf:"fixed32,3,opt,name=width,proto3" json:"width,omitempty"`
-	Height             float32                `protobuf:"fixed32,4,opt,name=height,proto3" json:"height,omitempty"`
-	Obstacles          []*Obstacle            `protobuf:"bytes,5,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
-	Zones              []*Zone                `protobuf:"bytes,6,rep,name=zones,proto3" json:"zones,omitempty"`
-	MaxBots            int32                  `protobuf:"varint,7,opt,name=max_bots,json=maxBots,proto3" json:"max_bots,omitempty"`
-	MatchDurationTicks int64                  `protobuf:"varint,8,opt,name=match_duration_ticks,json=matchDurationTicks,proto3" json:"match_duration_ticks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ReplayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	StartTick     int64                  `protobuf:"varint,2,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	EndTick       int64                  `protobuf:"varint,3,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	IncludeStates bool                   `protobuf:"varint,4,opt,name=include_states,json=includeStates,proto3" json:"include_states,omitempty"` // Also rebuild the full world state of every tick in range
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArenaConfig) Reset() {
-	*x = ArenaConfig{}
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
 	mi := &file_arena_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArenaConfig) String() string {
+func (x *ReplayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArenaConfig) ProtoMessage() {}
+func (*ReplayRequest) ProtoMessage() {}
 
-func (x *ArenaConfig) ProtoReflect() protoreflect.Message {
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_arena_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -60,92 +56,131 @@ func (x *ArenaConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArenaConfig.ProtoReflect.Descriptor instead.
-func (*ArenaConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
 	return file_arena_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ArenaConfig) GetId() string {
+func (x *ReplayRequest) GetMatchId() string {
 	if x != nil {
-		return x.Id
+		return x.MatchId
 	}
 	return ""
 }
 
-func (x *ArenaConfig) GetName() string {
+func (x *ReplayRequest) GetStartTick() int64 {
 	if x != nil {
-		return x.Name
+		return x.StartTick
 	}
-	return ""
+	return 0
 }
 
-func (x *ArenaConfig) GetWidth() float32 {
+func (x *ReplayRequest) GetEndTick() int64 {
 	if x != nil {
-		return x.Width
+		return x.EndTick
 	}
 	return 0
 }
 
-func (x *ArenaConfig) GetHeight() float32 {
+func (x *ReplayRequest) GetIncludeStates() bool {
 	if x != nil {
-		return x.Height
+		return x.IncludeStates
 	}
-	return 0
+	return false
+}
+
+type ReplayData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Events        []*SimulationEvent     `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	States        []*WorldState          `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"` // Only with include_states, ordered by tick
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayData) Reset() {
+	*x = ReplayData{}
+	mi := &file_arena_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayData) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ArenaConfig) GetObstacles() []*Obstacle {
+func (*ReplayData) ProtoMessage() {}
+
+func (x *ReplayData) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[1]
 	if x != nil {
-		return x.Obstacles
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ArenaConfig) GetZones() []*Zone {
+// Deprecated: Use ReplayData.ProtoReflect.Descriptor instead.
+func (*ReplayData) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplayData) GetMatchId() string {
 	if x != nil {
-		return x.Zones
+		return x.MatchId
 	}
-	return nil
+	return ""
 }
 
-func (x *ArenaConfig) GetMaxBots() int32 {
+func (x *ReplayData) GetEvents() []*SimulationEvent {
 	if x != nil {
-		return x.MaxBots
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-func (x *ArenaConfig) GetMatchDurationTicks() int64 {
+func (x *ReplayData) GetStates() []*WorldState {
 	if x != nil {
-		return x.MatchDurationTicks
+		return x.States
 	}
-	return 0
+	return nil
 }
 
-type Obstacle struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Position       *Vector3               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	Radius         float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"` // All obstacles are circular in v1
-	Indestructible bool                   `protobuf:"varint,4,opt,name=indestructible,proto3" json:"indestructible,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Changes from the previous tick's state, stored between replay keyframes
+type WorldStateDelta struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Tick               int64                  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Status             MatchStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=codearena.v1.MatchStatus" json:"status,omitempty"`
+	Bots               []*BotState            `protobuf:"bytes,3,rep,name=bots,proto3" json:"bots,omitempty"` // Bots that changed or joined
+	RemovedBotIds      []string               `protobuf:"bytes,4,rep,name=removed_bot_ids,json=removedBotIds,proto3" json:"removed_bot_ids,omitempty"`
+	Bullets            []*BulletState         `protobuf:"bytes,5,rep,name=bullets,proto3" json:"bullets,omitempty"` // Bullets move every tick, so always the full set
+	Zone               *ZoneState             `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
+	Obstacles          []*ObstacleState       `protobuf:"bytes,7,rep,name=obstacles,proto3" json:"obstacles,omitempty"` // Obstacles that changed
+	RemovedObstacleIds []string               `protobuf:"bytes,8,rep,name=removed_obstacle_ids,json=removedObstacleIds,proto3" json:"removed_obstacle_ids,omitempty"`
+	Events             []*SimulationEvent     `protobuf:"bytes,9,rep,name=events,proto3" json:"events,omitempty"`
+	Pickups            []*PickupState         `protobuf:"bytes,10,rep,name=pickups,proto3" json:"pickups,omitempty"` // Always the full set
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Obstacle) Reset() {
-	*x = Obstacle{}
-	mi := &file_arena_proto_msgTypes[1]
+func (x *WorldStateDelta) Reset() {
+	*x = WorldStateDelta{}
+	mi := &file_arena_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Obstacle) String() string {
+func (x *WorldStateDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Obstacle) ProtoMessage() {}
+func (*WorldStateDelta) ProtoMessage() {}
 
-func (x *Obstacle) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[1]
+func (x *WorldStateDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,65 +191,112 @@ func (x *Obstacle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Obstacle.ProtoReflect.Descriptor instead.
-func (*Obstacle) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use WorldStateDelta.ProtoReflect.Descriptor instead.
+func (*WorldStateDelta) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Obstacle) GetId() string {
+func (x *WorldStateDelta) GetTick() int64 {
 	if x != nil {
-		return x.Id
+		return x.Tick
 	}
-	return ""
+	return 0
 }
 
-func (x *Obstacle) GetPosition() *Vector3 {
+func (x *WorldStateDelta) GetStatus() MatchStatus {
 	if x != nil {
-		return x.Position
+		return x.Status
+	}
+	return MatchStatus_MATCH_STATUS_UNSPECIFIED
+}
+
+func (x *WorldStateDelta) GetBots() []*BotState {
+	if x != nil {
+		return x.Bots
 	}
 	return nil
 }
 
-func (x *Obstacle) GetRadius() float32 {
+func (x *WorldStateDelta) GetRemovedBotIds() []string {
 	if x != nil {
-		return x.Radius
+		return x.RemovedBotIds
 	}
-	return 0
+	return nil
 }
 
-func (x *Obstacle) GetIndestructible() bool {
+func (x *WorldStateDelta) GetBullets() []*BulletState {
 	if x != nil {
-		return x.Indestructible
+		return x.Bullets
 	}
-	return false
+	return nil
+}
+
+func (x *WorldStateDelta) GetZone() *ZoneState {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+func (x *WorldStateDelta) GetObstacles() []*ObstacleState {
+	if x != nil {
+		return x.Obstacles
+	}
+	return nil
 }
 
-type Zone struct {
+func (x *WorldStateDelta) GetRemovedObstacleIds() []string {
+	if x != nil {
+		return x.RemovedObstacleIds
+	}
+	return nil
+}
+
+func (x *WorldStateDelta) GetEvents() []*SimulationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WorldStateDelta) GetPickups() []*PickupState {
+	if x != nil {
+		return x.Pickups
+	}
+	return nil
+}
+
+// One recorded simulation step: the bots that joined and the intents consumed
+// before it, and the hash of the state it produced. Replaying the records of a
+// match must reproduce every hash.
+type TickRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Position      *Vector3               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	Radius        float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // HEAL, ENERGY, HAZARD
-	Intensity     float32                `protobuf:"fixed32,5,opt,name=intensity,proto3" json:"intensity,omitempty"`
+	Tick          int64                  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Arena         *ArenaConfig           `protobuf:"bytes,2,opt,name=arena,proto3" json:"arena,omitempty"` // Set on the first tick of a run
+	Joined        []*BotState            `protobuf:"bytes,3,rep,name=joined,proto3" json:"joined,omitempty"`
+	Intents       []*RecordedIntent      `protobuf:"bytes,4,rep,name=intents,proto3" json:"intents,omitempty"` // Sorted by bot ID
+	StateHash     uint64                 `protobuf:"varint,5,opt,name=state_hash,json=stateHash,proto3" json:"state_hash,omitempty"`
+	Connections   []*ConnectionChange    `protobuf:"bytes,6,rep,name=connections,proto3" json:"connections,omitempty"` // In the order they happened
+	Stepped       bool                   `protobuf:"varint,7,opt,name=stepped,proto3" json:"stepped,omitempty"`        // Stepped while paused, so intent deadlines did not apply
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Zone) Reset() {
-	*x = Zone{}
-	mi := &file_arena_proto_msgTypes[2]
+func (x *TickRecord) Reset() {
+	*x = TickRecord{}
+	mi := &file_arena_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Zone) String() string {
+func (x *TickRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Zone) ProtoMessage() {}
+func (*TickRecord) ProtoMessage() {}
 
-func (x *Zone) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[2]
+func (x *TickRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,70 +307,84 @@ func (x *Zone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
-func (*Zone) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TickRecord.ProtoReflect.Descriptor instead.
+func (*TickRecord) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Zone) GetId() string {
+func (x *TickRecord) GetTick() int64 {
 	if x != nil {
-		return x.Id
+		return x.Tick
 	}
-	return ""
+	return 0
 }
 
-func (x *Zone) GetPosition() *Vector3 {
+func (x *TickRecord) GetArena() *ArenaConfig {
 	if x != nil {
-		return x.Position
+		return x.Arena
 	}
 	return nil
 }
 
-func (x *Zone) GetRadius() float32 {
+func (x *TickRecord) GetJoined() []*BotState {
 	if x != nil {
-		return x.Radius
+		return x.Joined
 	}
-	return 0
+	return nil
 }
 
-func (x *Zone) GetType() string {
+func (x *TickRecord) GetIntents() []*RecordedIntent {
 	if x != nil {
-		return x.Type
+		return x.Intents
 	}
-	return ""
+	return nil
 }
 
-func (x *Zone) GetIntensity() float32 {
+func (x *TickRecord) GetStateHash() uint64 {
 	if x != nil {
-		return x.Intensity
+		return x.StateHash
 	}
 	return 0
 }
 
-type ReplayRequest struct {
+func (x *TickRecord) GetConnections() []*ConnectionChange {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *TickRecord) GetStepped() bool {
+	if x != nil {
+		return x.Stepped
+	}
+	return false
+}
+
+// A networked bot opening, dropping or resuming its session
+type ConnectionChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	StartTick     int64                  `protobuf:"varint,2,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
-	EndTick       int64                  `protobuf:"varint,3,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Connected     bool                   `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplayRequest) Reset() {
-	*x = ReplayRequest{}
-	mi := &file_arena_proto_msgTypes[3]
+func (x *ConnectionChange) Reset() {
+	*x = ConnectionChange{}
+	mi := &file_arena_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplayRequest) String() string {
+func (x *ConnectionChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplayRequest) ProtoMessage() {}
+func (*ConnectionChange) ProtoMessage() {}
 
-func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[3]
+func (x *ConnectionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,55 +395,48 @@ func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
-func (*ReplayRequest) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ConnectionChange.ProtoReflect.Descriptor instead.
+func (*ConnectionChange) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ReplayRequest) GetMatchId() string {
+func (x *ConnectionChange) GetBotId() string {
 	if x != nil {
-		return x.MatchId
+		return x.BotId
 	}
 	return ""
 }
 
-func (x *ReplayRequest) GetStartTick() int64 {
-	if x != nil {
-		return x.StartTick
-	}
-	return 0
-}
-
-func (x *ReplayRequest) GetEndTick() int64 {
+func (x *ConnectionChange) GetConnected() bool {
 	if x != nil {
-		return x.EndTick
+		return x.Connected
 	}
-	return 0
+	return false
 }
 
-type ReplayData struct {
+type RecordedIntent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
-	Events        []*SimulationEvent     `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Intent        *BotIntent             `protobuf:"bytes,2,opt,name=intent,proto3" json:"intent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplayData) Reset() {
-	*x = ReplayData{}
-	mi := &file_arena_proto_msgTypes[4]
+func (x *RecordedIntent) Reset() {
+	*x = RecordedIntent{}
+	mi := &file_arena_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplayData) String() string {
+func (x *RecordedIntent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplayData) ProtoMessage() {}
+func (*RecordedIntent) ProtoMessage() {}
 
-func (x *ReplayData) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[4]
+func (x *RecordedIntent) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,21 +447,21 @@ func (x *ReplayData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplayData.ProtoReflect.Descriptor instead.
-func (*ReplayData) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RecordedIntent.ProtoReflect.Descriptor instead.
+func (*RecordedIntent) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ReplayData) GetMatchId() string {
+func (x *RecordedIntent) GetBotId() string {
 	if x != nil {
-		return x.MatchId
+		return x.BotId
 	}
 	return ""
 }
 
-func (x *ReplayData) GetEvents() []*SimulationEvent {
+func (x *RecordedIntent) GetIntent() *BotIntent {
 	if x != nil {
-		return x.Events
+		return x.Intent
 	}
 	return nil
 }
@@ -388,7 +477,7 @@ type HighlightMoment struct {
 
 func (x *HighlightMoment) Reset() {
 	*x = HighlightMoment{}
-	mi := &file_arena_proto_msgTypes[5]
+	mi := &file_arena_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +489,7 @@ func (x *HighlightMoment) String() string {
 func (*HighlightMoment) ProtoMessage() {}
 
 func (x *HighlightMoment) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[5]
+	mi := &file_arena_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +502,7 @@ func (x *HighlightMoment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HighlightMoment.ProtoReflect.Descriptor instead.
 func (*HighlightMoment) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{5}
+	return file_arena_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HighlightMoment) GetTick() int64 {
@@ -447,7 +536,7 @@ type HighlightsData struct {
 
 func (x *HighlightsData) Reset() {
 	*x = HighlightsData{}
-	mi := &file_arena_proto_msgTypes[6]
+	mi := &file_arena_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +548,7 @@ func (x *HighlightsData) String() string {
 func (*HighlightsData) ProtoMessage() {}
 
 func (x *HighlightsData) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[6]
+	mi := &file_arena_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +561,7 @@ func (x *HighlightsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HighlightsData.ProtoReflect.Descriptor instead.
 func (*HighlightsData) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{6}
+	return file_arena_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HighlightsData) GetMatchId() string {
@@ -502,7 +591,7 @@ type RegisterBotRequest struct {
 
 func (x *RegisterBotRequest) Reset() {
 	*x = RegisterBotRequest{}
-	mi := &file_arena_proto_msgTypes[7]
+	mi := &file_arena_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +603,7 @@ func (x *RegisterBotRequest) String() string {
 func (*RegisterBotRequest) ProtoMessage() {}
 
 func (x *RegisterBotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[7]
+	mi := &file_arena_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +616,7 @@ func (x *RegisterBotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterBotRequest.ProtoReflect.Descriptor instead.
 func (*RegisterBotRequest) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{7}
+	return file_arena_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RegisterBotRequest) GetUserId() string {
@@ -577,7 +666,7 @@ type RegisterBotResponse struct {
 
 func (x *RegisterBotResponse) Reset() {
 	*x = RegisterBotResponse{}
-	mi := &file_arena_proto_msgTypes[8]
+	mi := &file_arena_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -589,7 +678,7 @@ func (x *RegisterBotResponse) String() string {
 func (*RegisterBotResponse) ProtoMessage() {}
 
 func (x *RegisterBotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[8]
+	mi := &file_arena_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +691,7 @@ func (x *RegisterBotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterBotResponse.ProtoReflect.Descriptor instead.
 func (*RegisterBotResponse) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{8}
+	return file_arena_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RegisterBotResponse) GetBotId() string {
@@ -642,7 +731,7 @@ type MatchRequest struct {
 
 func (x *MatchRequest) Reset() {
 	*x = MatchRequest{}
-	mi := &file_arena_proto_msgTypes[9]
+	mi := &file_arena_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +743,7 @@ func (x *MatchRequest) String() string {
 func (*MatchRequest) ProtoMessage() {}
 
 func (x *MatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[9]
+	mi := &file_arena_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +756,7 @@ func (x *MatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchRequest.ProtoReflect.Descriptor instead.
 func (*MatchRequest) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{9}
+	return file_arena_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MatchRequest) GetMatchId() string {
@@ -687,7 +776,7 @@ type MatchResponse struct {
 
 func (x *MatchResponse) Reset() {
 	*x = MatchResponse{}
-	mi := &file_arena_proto_msgTypes[10]
+	mi := &file_arena_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +788,7 @@ func (x *MatchResponse) String() string {
 func (*MatchResponse) ProtoMessage() {}
 
 func (x *MatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[10]
+	mi := &file_arena_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +801,7 @@ func (x *MatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchResponse.ProtoReflect.Descriptor instead.
 func (*MatchResponse) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{10}
+	return file_arena_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MatchResponse) GetMatchId() string {
@@ -738,7 +827,7 @@ type MatchList struct {
 
 func (x *MatchList) Reset() {
 	*x = MatchList{}
-	mi := &file_arena_proto_msgTypes[11]
+	mi := &file_arena_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -750,7 +839,7 @@ func (x *MatchList) String() string {
 func (*MatchList) ProtoMessage() {}
 
 func (x *MatchList) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[11]
+	mi := &file_arena_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +852,7 @@ func (x *MatchList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchList.ProtoReflect.Descriptor instead.
 func (*MatchList) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{11}
+	return file_arena_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MatchList) GetMatches() []*MatchResponse {
@@ -781,7 +870,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_arena_proto_msgTypes[12]
+	mi := &file_arena_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +882,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[12]
+	mi := &file_arena_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +895,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{12}
+	return file_arena_proto_rawDescGZIP(), []int{13}
 }
 
 type StopSimulationRequest struct {
@@ -818,7 +907,7 @@ type StopSimulationRequest struct {
 
 func (x *StopSimulationRequest) Reset() {
 	*x = StopSimulationRequest{}
-	mi := &file_arena_proto_msgTypes[13]
+	mi := &file_arena_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -830,7 +919,7 @@ func (x *StopSimulationRequest) String() string {
 func (*StopSimulationRequest) ProtoMessage() {}
 
 func (x *StopSimulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[13]
+	mi := &file_arena_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +932,7 @@ func (x *StopSimulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopSimulationRequest.ProtoReflect.Descriptor instead.
 func (*StopSimulationRequest) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{13}
+	return file_arena_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StopSimulationRequest) GetMatchId() string {
@@ -853,16 +942,122 @@ func (x *StopSimulationRequest) GetMatchId() string {
 	return ""
 }
 
-type SimulationResponse struct {
+type StepSimulationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        MatchStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=codearena.v1.MatchStatus" json:"status,omitempty"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Ticks         int32                  `protobuf:"varint,2,opt,name=ticks,proto3" json:"ticks,omitempty"` // Ticks to advance; 0 = 1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *StepSimulationRequest) Reset() {
+	*x = StepSimulationRequest{}
+	mi := &file_arena_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepSimulationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepSimulationRequest) ProtoMessage() {}
+
+func (x *StepSimulationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepSimulationRequest.ProtoReflect.Descriptor instead.
+func (*StepSimulationRequest) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StepSimulationRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *StepSimulationRequest) GetTicks() int32 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+type SetTickRateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MatchId        string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	TicksPerSecond float32                `protobuf:"fixed32,2,opt,name=ticks_per_second,json=ticksPerSecond,proto3" json:"ticks_per_second,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetTickRateRequest) Reset() {
+	*x = SetTickRateRequest{}
+	mi := &file_arena_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTickRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTickRateRequest) ProtoMessage() {}
+
+func (x *SetTickRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arena_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTickRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTickRateRequest) Descriptor() ([]byte, []int) {
+	return file_arena_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetTickRateRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *SetTickRateRequest) GetTicksPerSecond() float32 {
+	if x != nil {
+		return x.TicksPerSecond
+	}
+	return 0
+}
+
+type SimulationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         MatchStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=codearena.v1.MatchStatus" json:"status,omitempty"`
+	Tick           int64                  `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`                                              // Last simulated tick
+	TicksPerSecond float32                `protobuf:"fixed32,3,opt,name=ticks_per_second,json=ticksPerSecond,proto3" json:"ticks_per_second,omitempty"` // Game loop speed; 0 when the match has no loop
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *SimulationResponse) Reset() {
 	*x = SimulationResponse{}
-	mi := &file_arena_proto_msgTypes[14]
+	mi := &file_arena_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +1069,7 @@ func (x *SimulationResponse) String() string {
 func (*SimulationResponse) ProtoMessage() {}
 
 func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arena_proto_msgTypes[14]
+	mi := &file_arena_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1082,7 @@ func (x *SimulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulationResponse.ProtoReflect.Descriptor instead.
 func (*SimulationResponse) Descriptor() ([]byte, []int) {
-	return file_arena_proto_rawDescGZIP(), []int{14}
+	return file_arena_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SimulationResponse) GetStatus() MatchStatus {
@@ -897,40 +1092,64 @@ func (x *SimulationResponse) GetStatus() MatchStatus {
 	return MatchStatus_MATCH_STATUS_UNSPECIFIED
 }
 
+func (x *SimulationResponse) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *SimulationResponse) GetTicksPerSecond() float32 {
+	if x != nil {
+		return x.TicksPerSecond
+	}
+	return 0
+}
+
 var File_arena_proto protoreflect.FileDescriptor
 
 const file_arena_proto_rawDesc = "" +
 	"\n" +
-	"\varena.proto\x12\fcodearena.v1\x1a\rbot_api.proto\"\x8c\x02\n" +
-	"\vArenaConfig\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05width\x18\x03 \x01(\x02R\x05width\x12\x16\n" +
-	"\x06height\x18\x04 \x01(\x02R\x06height\x124\n" +
-	"\tobstacles\x18\x05 \x03(\v2\x16.codearena.v1.ObstacleR\tobstacles\x12(\n" +
-	"\x05zones\x18\x06 \x03(\v2\x12.codearena.v1.ZoneR\x05zones\x12\x19\n" +
-	"\bmax_bots\x18\a \x01(\x05R\amaxBots\x120\n" +
-	"\x14match_duration_ticks\x18\b \x01(\x03R\x12matchDurationTicks\"\x8d\x01\n" +
-	"\bObstacle\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
-	"\bposition\x18\x02 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x16\n" +
-	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12&\n" +
-	"\x0eindestructible\x18\x04 \x01(\bR\x0eindestructible\"\x93\x01\n" +
-	"\x04Zone\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
-	"\bposition\x18\x02 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x16\n" +
-	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
-	"\tintensity\x18\x05 \x01(\x02R\tintensity\"d\n" +
+	"\varena.proto\x12\fcodearena.v1\x1a\rbot_api.proto\"\x8b\x01\n" +
 	"\rReplayRequest\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x1d\n" +
 	"\n" +
 	"start_tick\x18\x02 \x01(\x03R\tstartTick\x12\x19\n" +
-	"\bend_tick\x18\x03 \x01(\x03R\aendTick\"^\n" +
+	"\bend_tick\x18\x03 \x01(\x03R\aendTick\x12%\n" +
+	"\x0einclude_states\x18\x04 \x01(\bR\rincludeStates\"\x90\x01\n" +
 	"\n" +
 	"ReplayData\x12\x19\n" +
 	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x125\n" +
-	"\x06events\x18\x02 \x03(\v2\x1d.codearena.v1.SimulationEventR\x06events\"[\n" +
+	"\x06events\x18\x02 \x03(\v2\x1d.codearena.v1.SimulationEventR\x06events\x120\n" +
+	"\x06states\x18\x03 \x03(\v2\x18.codearena.v1.WorldStateR\x06states\"\xe7\x03\n" +
+	"\x0fWorldStateDelta\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\x03R\x04tick\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.codearena.v1.MatchStatusR\x06status\x12*\n" +
+	"\x04bots\x18\x03 \x03(\v2\x16.codearena.v1.BotStateR\x04bots\x12&\n" +
+	"\x0fremoved_bot_ids\x18\x04 \x03(\tR\rremovedBotIds\x123\n" +
+	"\abullets\x18\x05 \x03(\v2\x19.codearena.v1.BulletStateR\abullets\x12+\n" +
+	"\x04zone\x18\x06 \x01(\v2\x17.codearena.v1.ZoneStateR\x04zone\x129\n" +
+	"\tobstacles\x18\a \x03(\v2\x1b.codearena.v1.ObstacleStateR\tobstacles\x120\n" +
+	"\x14removed_obstacle_ids\x18\b \x03(\tR\x12removedObstacleIds\x125\n" +
+	"\x06events\x18\t \x03(\v2\x1d.codearena.v1.SimulationEventR\x06events\x123\n" +
+	"\apickups\x18\n" +
+	" \x03(\v2\x19.codearena.v1.PickupStateR\apickups\"\xb4\x02\n" +
+	"\n" +
+	"TickRecord\x12\x12\n" +
+	"\x04tick\x18\x01 \x01(\x03R\x04tick\x12/\n" +
+	"\x05arena\x18\x02 \x01(\v2\x19.codearena.v1.ArenaConfigR\x05arena\x12.\n" +
+	"\x06joined\x18\x03 \x03(\v2\x16.codearena.v1.BotStateR\x06joined\x126\n" +
+	"\aintents\x18\x04 \x03(\v2\x1c.codearena.v1.RecordedIntentR\aintents\x12\x1d\n" +
+	"\n" +
+	"state_hash\x18\x05 \x01(\x04R\tstateHash\x12@\n" +
+	"\vconnections\x18\x06 \x03(\v2\x1e.codearena.v1.ConnectionChangeR\vconnections\x12\x18\n" +
+	"\astepped\x18\a \x01(\bR\astepped\"G\n" +
+	"\x10ConnectionChange\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\bR\tconnected\"X\n" +
+	"\x0eRecordedIntent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12/\n" +
+	"\x06intent\x18\x02 \x01(\v2\x17.codearena.v1.BotIntentR\x06intent\"[\n" +
 	"\x0fHighlightMoment\x12\x12\n" +
 	"\x04tick\x18\x01 \x01(\x03R\x04tick\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
@@ -960,9 +1179,17 @@ const file_arena_proto_rawDesc = "" +
 	"\amatches\x18\x01 \x03(\v2\x1b.codearena.v1.MatchResponseR\amatches\"\a\n" +
 	"\x05Empty\"2\n" +
 	"\x15StopSimulationRequest\x12\x19\n" +
-	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"G\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"H\n" +
+	"\x15StepSimulationRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x14\n" +
+	"\x05ticks\x18\x02 \x01(\x05R\x05ticks\"Y\n" +
+	"\x12SetTickRateRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12(\n" +
+	"\x10ticks_per_second\x18\x02 \x01(\x02R\x0eticksPerSecond\"\x85\x01\n" +
 	"\x12SimulationResponse\x121\n" +
-	"\x06status\x18\x01 \x01(\x0e2\x19.codearena.v1.MatchStatusR\x06status2\x89\x04\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.codearena.v1.MatchStatusR\x06status\x12\x12\n" +
+	"\x04tick\x18\x02 \x01(\x03R\x04tick\x12(\n" +
+	"\x10ticks_per_second\x18\x03 \x01(\x02R\x0eticksPerSecond2\x89\x04\n" +
 	"\fMatchService\x12E\n" +
 	"\vCreateMatch\x12\x19.codearena.v1.ArenaConfig\x1a\x1b.codearena.v1.MatchResponse\x12A\n" +
 	"\x11ListActiveMatches\x12\x13.codearena.v1.Empty\x1a\x17.codearena.v1.MatchList\x12;\n" +
@@ -971,10 +1198,14 @@ const file_arena_proto_rawDesc = "" +
 	"WatchMatch\x12\x1a.codearena.v1.MatchRequest\x1a\x18.codearena.v1.WorldState0\x01\x12R\n" +
 	"\vRegisterBot\x12 .codearena.v1.RegisterBotRequest\x1a!.codearena.v1.RegisterBotResponse\x12G\n" +
 	"\x0eGetMatchReplay\x12\x1b.codearena.v1.ReplayRequest\x1a\x18.codearena.v1.ReplayData\x12O\n" +
-	"\x12GetMatchHighlights\x12\x1b.codearena.v1.ReplayRequest\x1a\x1c.codearena.v1.HighlightsData2\xbc\x01\n" +
+	"\x12GetMatchHighlights\x12\x1b.codearena.v1.ReplayRequest\x1a\x1c.codearena.v1.HighlightsData2\x8b\x04\n" +
 	"\x11SimulationService\x12N\n" +
 	"\x0fStartSimulation\x12\x19.codearena.v1.ArenaConfig\x1a .codearena.v1.SimulationResponse\x12W\n" +
-	"\x0eStopSimulation\x12#.codearena.v1.StopSimulationRequest\x1a .codearena.v1.SimulationResponseB9Z7github.com/codearena-platform/codearena-core/pkg/api/v1b\x06proto3"
+	"\x0eStopSimulation\x12#.codearena.v1.StopSimulationRequest\x1a .codearena.v1.SimulationResponse\x12O\n" +
+	"\x0fPauseSimulation\x12\x1a.codearena.v1.MatchRequest\x1a .codearena.v1.SimulationResponse\x12P\n" +
+	"\x10ResumeSimulation\x12\x1a.codearena.v1.MatchRequest\x1a .codearena.v1.SimulationResponse\x12W\n" +
+	"\x0eStepSimulation\x12#.codearena.v1.StepSimulationRequest\x1a .codearena.v1.SimulationResponse\x12Q\n" +
+	"\vSetTickRate\x12 .codearena.v1.SetTickRateRequest\x1a .codearena.v1.SimulationResponseB9Z7github.com/codearena-platform/codearena-core/pkg/api/v1b\x06proto3"
 
 var (
 	file_arena_proto_rawDescOnce sync.Once
@@ -988,61 +1219,87 @@ func file_arena_proto_rawDescGZIP() []byte {
 	return file_arena_proto_rawDescData
 }
 
-var file_arena_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_arena_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_arena_proto_goTypes = []any{
-	(*ArenaConfig)(nil),           // 0: codearena.v1.ArenaConfig
-	(*Obstacle)(nil),              // 1: codearena.v1.Obstacle
-	(*Zone)(nil),                  // 2: codearena.v1.Zone
-	(*ReplayRequest)(nil),         // 3: codearena.v1.ReplayRequest
-	(*ReplayData)(nil),            // 4: codearena.v1.ReplayData
-	(*HighlightMoment)(nil),       // 5: codearena.v1.HighlightMoment
-	(*HighlightsData)(nil),        // 6: codearena.v1.HighlightsData
-	(*RegisterBotRequest)(nil),    // 7: codearena.v1.RegisterBotRequest
-	(*RegisterBotResponse)(nil),   // 8: codearena.v1.RegisterBotResponse
-	(*MatchRequest)(nil),          // 9: codearena.v1.MatchRequest
-	(*MatchResponse)(nil),         // 10: codearena.v1.MatchResponse
-	(*MatchList)(nil),             // 11: codearena.v1.MatchList
-	(*Empty)(nil),                 // 12: codearena.v1.Empty
-	(*StopSimulationRequest)(nil), // 13: codearena.v1.StopSimulationRequest
-	(*SimulationResponse)(nil),    // 14: codearena.v1.SimulationResponse
-	(*Vector3)(nil),               // 15: codearena.v1.Vector3
-	(*SimulationEvent)(nil),       // 16: codearena.v1.SimulationEvent
-	(MatchStatus)(0),              // 17: codearena.v1.MatchStatus
-	(*WorldState)(nil),            // 18: codearena.v1.WorldState
+	(*ReplayRequest)(nil),         // 0: codearena.v1.ReplayRequest
+	(*ReplayData)(nil),            // 1: codearena.v1.ReplayData
+	(*WorldStateDelta)(nil),       // 2: codearena.v1.WorldStateDelta
+	(*TickRecord)(nil),            // 3: codearena.v1.TickRecord
+	(*ConnectionChange)(nil),      // 4: codearena.v1.ConnectionChange
+	(*RecordedIntent)(nil),        // 5: codearena.v1.RecordedIntent
+	(*HighlightMoment)(nil),       // 6: codearena.v1.HighlightMoment
+	(*HighlightsData)(nil),        // 7: codearena.v1.HighlightsData
+	(*RegisterBotRequest)(nil),    // 8: codearena.v1.RegisterBotRequest
+	(*RegisterBotResponse)(nil),   // 9: codearena.v1.RegisterBotResponse
+	(*MatchRequest)(nil),          // 10: codearena.v1.MatchRequest
+	(*MatchResponse)(nil),         // 11: codearena.v1.MatchResponse
+	(*MatchList)(nil),             // 12: codearena.v1.MatchList
+	(*Empty)(nil),                 // 13: codearena.v1.Empty
+	(*StopSimulationRequest)(nil), // 14: codearena.v1.StopSimulationRequest
+	(*StepSimulationRequest)(nil), // 15: codearena.v1.StepSimulationRequest
+	(*SetTickRateRequest)(nil),    // 16: codearena.v1.SetTickRateRequest
+	(*SimulationResponse)(nil),    // 17: codearena.v1.SimulationResponse
+	(*SimulationEvent)(nil),       // 18: codearena.v1.SimulationEvent
+	(*WorldState)(nil),            // 19: codearena.v1.WorldState
+	(MatchStatus)(0),              // 20: codearena.v1.MatchStatus
+	(*BotState)(nil),              // 21: codearena.v1.BotState
+	(*BulletState)(nil),           // 22: codearena.v1.BulletState
+	(*ZoneState)(nil),             // 23: codearena.v1.ZoneState
+	(*ObstacleState)(nil),         // 24: codearena.v1.ObstacleState
+	(*PickupState)(nil),           // 25: codearena.v1.PickupState
+	(*ArenaConfig)(nil),           // 26: codearena.v1.ArenaConfig
+	(*BotIntent)(nil),             // 27: codearena.v1.BotIntent
 }
 var file_arena_proto_depIdxs = []int32{
-	1,  // 0: codearena.v1.ArenaConfig.obstacles:type_name -> codearena.v1.Obstacle
-	2,  // 1: codearena.v1.ArenaConfig.zones:type_name -> codearena.v1.Zone
-	15, // 2: codearena.v1.Obstacle.position:type_name -> codearena.v1.Vector3
-	15, // 3: codearena.v1.Zone.position:type_name -> codearena.v1.Vector3
-	16, // 4: codearena.v1.ReplayData.events:type_name -> codearena.v1.SimulationEvent
-	5,  // 5: codearena.v1.HighlightsData.moments:type_name -> codearena.v1.HighlightMoment
-	17, // 6: codearena.v1.MatchResponse.status:type_name -> codearena.v1.MatchStatus
-	10, // 7: codearena.v1.MatchList.matches:type_name -> codearena.v1.MatchResponse
-	17, // 8: codearena.v1.SimulationResponse.status:type_name -> codearena.v1.MatchStatus
-	0,  // 9: codearena.v1.MatchService.CreateMatch:input_type -> codearena.v1.ArenaConfig
-	12, // 10: codearena.v1.MatchService.ListActiveMatches:input_type -> codearena.v1.Empty
-	12, // 11: codearena.v1.MatchService.ListMatches:input_type -> codearena.v1.Empty
-	9,  // 12: codearena.v1.MatchService.WatchMatch:input_type -> codearena.v1.MatchRequest
-	7,  // 13: codearena.v1.MatchService.RegisterBot:input_type -> codearena.v1.RegisterBotRequest
-	3,  // 14: codearena.v1.MatchService.GetMatchReplay:input_type -> codearena.v1.ReplayRequest
-	3,  // 15: codearena.v1.MatchService.GetMatchHighlights:input_type -> codearena.v1.ReplayRequest
-	0,  // 16: codearena.v1.SimulationService.StartSimulation:input_type -> codearena.v1.ArenaConfig
-	13, // 17: codearena.v1.SimulationService.StopSimulation:input_type -> codearena.v1.StopSimulationRequest
-	10, // 18: codearena.v1.MatchService.CreateMatch:output_type -> codearena.v1.MatchResponse
-	11, // 19: codearena.v1.MatchService.ListActiveMatches:output_type -> codearena.v1.MatchList
-	11, // 20: codearena.v1.MatchService.ListMatches:output_type -> codearena.v1.MatchList
-	18, // 21: codearena.v1.MatchService.WatchMatch:output_type -> codearena.v1.WorldState
-	8,  // 22: codearena.v1.MatchService.RegisterBot:output_type -> codearena.v1.RegisterBotResponse
-	4,  // 23: codearena.v1.MatchService.GetMatchReplay:output_type -> codearena.v1.ReplayData
-	6,  // 24: codearena.v1.MatchService.GetMatchHighlights:output_type -> codearena.v1.HighlightsData
-	14, // 25: codearena.v1.SimulationService.StartSimulation:output_type -> codearena.v1.SimulationResponse
-	14, // 26: codearena.v1.SimulationService.StopSimulation:output_type -> codearena.v1.SimulationResponse
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	18, // 0: codearena.v1.ReplayData.events:type_name -> codearena.v1.SimulationEvent
+	19, // 1: codearena.v1.ReplayData.states:type_name -> codearena.v1.WorldState
+	20, // 2: codearena.v1.WorldStateDelta.status:type_name -> codearena.v1.MatchStatus
+	21, // 3: codearena.v1.WorldStateDelta.bots:type_name -> codearena.v1.BotState
+	22, // 4: codearena.v1.WorldStateDelta.bullets:type_name -> codearena.v1.BulletState
+	23, // 5: codearena.v1.WorldStateDelta.zone:type_name -> codearena.v1.ZoneState
+	24, // 6: codearena.v1.WorldStateDelta.obstacles:type_name -> codearena.v1.ObstacleState
+	18, // 7: codearena.v1.WorldStateDelta.events:type_name -> codearena.v1.SimulationEvent
+	25, // 8: codearena.v1.WorldStateDelta.pickups:type_name -> codearena.v1.PickupState
+	26, // 9: codearena.v1.TickRecord.arena:type_name -> codearena.v1.ArenaConfig
+	21, // 10: codearena.v1.TickRecord.joined:type_name -> codearena.v1.BotState
+	5,  // 11: codearena.v1.TickRecord.intents:type_name -> codearena.v1.RecordedIntent
+	4,  // 12: codearena.v1.TickRecord.connections:type_name -> codearena.v1.ConnectionChange
+	27, // 13: codearena.v1.RecordedIntent.intent:type_name -> codearena.v1.BotIntent
+	6,  // 14: codearena.v1.HighlightsData.moments:type_name -> codearena.v1.HighlightMoment
+	20, // 15: codearena.v1.MatchResponse.status:type_name -> codearena.v1.MatchStatus
+	11, // 16: codearena.v1.MatchList.matches:type_name -> codearena.v1.MatchResponse
+	20, // 17: codearena.v1.SimulationResponse.status:type_name -> codearena.v1.MatchStatus
+	26, // 18: codearena.v1.MatchService.CreateMatch:input_type -> codearena.v1.ArenaConfig
+	13, // 19: codearena.v1.MatchService.ListActiveMatches:input_type -> codearena.v1.Empty
+	13, // 20: codearena.v1.MatchService.ListMatches:input_type -> codearena.v1.Empty
+	10, // 21: codearena.v1.MatchService.WatchMatch:input_type -> codearena.v1.MatchRequest
+	8,  // 22: codearena.v1.MatchService.RegisterBot:input_type -> codearena.v1.RegisterBotRequest
+	0,  // 23: codearena.v1.MatchService.GetMatchReplay:input_type -> codearena.v1.ReplayRequest
+	0,  // 24: codearena.v1.MatchService.GetMatchHighlights:input_type -> codearena.v1.ReplayRequest
+	26, // 25: codearena.v1.SimulationService.StartSimulation:input_type -> codearena.v1.ArenaConfig
+	14, // 26: codearena.v1.SimulationService.StopSimulation:input_type -> codearena.v1.StopSimulationRequest
+	10, // 27: codearena.v1.SimulationService.PauseSimulation:input_type -> codearena.v1.MatchRequest
+	10, // 28: codearena.v1.SimulationService.ResumeSimulation:input_type -> codearena.v1.MatchRequest
+	15, // 29: codearena.v1.SimulationService.StepSimulation:input_type -> codearena.v1.StepSimulationRequest
+	16, // 30: codearena.v1.SimulationService.SetTickRate:input_type -> codearena.v1.SetTickRateRequest
+	11, // 31: codearena.v1.MatchService.CreateMatch:output_type -> codearena.v1.MatchResponse
+	12, // 32: codearena.v1.MatchService.ListActiveMatches:output_type -> codearena.v1.MatchList
+	12, // 33: codearena.v1.MatchService.ListMatches:output_type -> codearena.v1.MatchList
+	19, // 34: codearena.v1.MatchService.WatchMatch:output_type -> codearena.v1.WorldState
+	9,  // 35: codearena.v1.MatchService.RegisterBot:output_type -> codearena.v1.RegisterBotResponse
+	1,  // 36: codearena.v1.MatchService.GetMatchReplay:output_type -> codearena.v1.ReplayData
+	7,  // 37: codearena.v1.MatchService.GetMatchHighlights:output_type -> codearena.v1.HighlightsData
+	17, // 38: codearena.v1.SimulationService.StartSimulation:output_type -> codearena.v1.SimulationResponse
+	17, // 39: codearena.v1.SimulationService.StopSimulation:output_type -> codearena.v1.SimulationResponse
+	17, // 40: codearena.v1.SimulationService.PauseSimulation:output_type -> codearena.v1.SimulationResponse
+	17, // 41: codearena.v1.SimulationService.ResumeSimulation:output_type -> codearena.v1.SimulationResponse
+	17, // 42: codearena.v1.SimulationService.StepSimulation:output_type -> codearena.v1.SimulationResponse
+	17, // 43: codearena.v1.SimulationService.SetTickRate:output_type -> codearena.v1.SimulationResponse
+	31, // [31:44] is the sub-list for method output_type
+	18, // [18:31] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_arena_proto_init() }
@@ -1057,7 +1314,7 @@ func file_arena_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arena_proto_rawDesc), len(file_arena_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
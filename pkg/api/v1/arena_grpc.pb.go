@@ -353,8 +353,12 @@ var MatchService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	SimulationService_StartSimulation_FullMethodName = "/codearena.v1.SimulationService/StartSimulation"
-	SimulationService_StopSimulation_FullMethodName  = "/codearena.v1.SimulationService/StopSimulation"
+	SimulationService_StartSimulation_FullMethodName  = "/codearena.v1.SimulationService/StartSimulation"
+	SimulationService_StopSimulation_FullMethodName   = "/codearena.v1.SimulationService/StopSimulation"
+	SimulationService_PauseSimulation_FullMethodName  = "/codearena.v1.SimulationService/PauseSimulation"
+	SimulationService_ResumeSimulation_FullMethodName = "/codearena.v1.SimulationService/ResumeSimulation"
+	SimulationService_StepSimulation_FullMethodName   = "/codearena.v1.SimulationService/StepSimulation"
+	SimulationService_SetTickRate_FullMethodName      = "/codearena.v1.SimulationService/SetTickRate"
 )
 
 // SimulationServiceClient is the client API for SimulationService service.
@@ -364,6 +368,12 @@ type SimulationServiceClient interface {
 	// External control for starting/stopping the engine
 	StartSimulation(ctx context.Context, in *ArenaConfig, opts ...grpc.CallOption) (*SimulationResponse, error)
 	StopSimulation(ctx context.Context, in *StopSimulationRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
+	// Debugging controls: freeze a running match, advance it tick by tick and
+	// change its speed
+	PauseSimulation(ctx context.Context, in *MatchRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
+	ResumeSimulation(ctx context.Context, in *MatchRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
+	StepSimulation(ctx context.Context, in *StepSimulationRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
+	SetTickRate(ctx context.Context, in *SetTickRateRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
 }
 
 type simulationServiceClient struct {
@@ -394,6 +404,46 @@ func (c *simulationServiceClient) StopSimulation(ctx context.Context, in *StopSi
 	return out, nil
 }
 
+func (c *simulationServiceClient) PauseSimulation(ctx context.Context, in *MatchRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationResponse)
+	err := c.cc.Invoke(ctx, SimulationService_PauseSimulation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) ResumeSimulation(ctx context.Context, in *MatchRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationResponse)
+	err := c.cc.Invoke(ctx, SimulationService_ResumeSimulation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) StepSimulation(ctx context.Context, in *StepSimulationRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationResponse)
+	err := c.cc.Invoke(ctx, SimulationService_StepSimulation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) SetTickRate(ctx context.Context, in *SetTickRateRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationResponse)
+	err := c.cc.Invoke(ctx, SimulationService_SetTickRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SimulationServiceServer is the server API for SimulationService service.
 // All implementations must embed UnimplementedSimulationServiceServer
 // for forward compatibility.
@@ -401,6 +451,12 @@ type SimulationServiceServer interface {
 	// External control for starting/stopping the engine
 	StartSimulation(context.Context, *ArenaConfig) (*SimulationResponse, error)
 	StopSimulation(context.Context, *StopSimulationRequest) (*SimulationResponse, error)
+	// Debugging controls: freeze a running match, advance it tick by tick and
+	// change its speed
+	PauseSimulation(context.Context, *MatchRequest) (*SimulationResponse, error)
+	ResumeSimulation(context.Context, *MatchRequest) (*SimulationResponse, error)
+	StepSimulation(context.Context, *StepSimulationRequest) (*SimulationResponse, error)
+	SetTickRate(context.Context, *SetTickRateRequest) (*SimulationResponse, error)
 	mustEmbedUnimplementedSimulationServiceServer()
 }
 
@@ -417,6 +473,18 @@ func (UnimplementedSimulationServiceServer) StartSimulation(context.Context, *Ar
 func (UnimplementedSimulationServiceServer) StopSimulation(context.Context, *StopSimulationRequest) (*SimulationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StopSimulation not implemented")
 }
+func (UnimplementedSimulationServiceServer) PauseSimulation(context.Context, *MatchRequest) (*SimulationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseSimulation not implemented")
+}
+func (UnimplementedSimulationServiceServer) ResumeSimulation(context.Context, *MatchRequest) (*SimulationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeSimulation not implemented")
+}
+func (UnimplementedSimulationServiceServer) StepSimulation(context.Context, *StepSimulationRequest) (*SimulationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StepSimulation not implemented")
+}
+func (UnimplementedSimulationServiceServer) SetTickRate(context.Context, *SetTickRateRequest) (*SimulationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTickRate not implemented")
+}
 func (UnimplementedSimulationServiceServer) mustEmbedUnimplementedSimulationServiceServer() {}
 func (UnimplementedSimulationServiceServer) testEmbeddedByValue()                           {}
 
@@ -474,6 +542,78 @@ func _SimulationService_StopSimulation_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SimulationService_PauseSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).PauseSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_PauseSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).PauseSimulation(ctx, req.(*MatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_ResumeSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).ResumeSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_ResumeSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).ResumeSimulation(ctx, req.(*MatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_StepSimulation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepSimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).StepSimulation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_StepSimulation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).StepSimulation(ctx, req.(*StepSimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_SetTickRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTickRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).SetTickRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimulationService_SetTickRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).SetTickRate(ctx, req.(*SetTickRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SimulationService_ServiceDesc is the grpc.ServiceDesc for SimulationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -489,6 +629,22 @@ var SimulationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopSimulation",
 			Handler:    _SimulationService_StopSimulation_Handler,
 		},
+		{
+			MethodName: "PauseSimulation",
+			Handler:    _SimulationService_PauseSimulation_Handler,
+		},
+		{
+			MethodName: "ResumeSimulation",
+			Handler:    _SimulationService_ResumeSimulation_Handler,
+		},
+		{
+			MethodName: "StepSimulation",
+			Handler:    _SimulationService_StepSimulation_Handler,
+		},
+		{
+			MethodName: "SetTickRate",
+			Handler:    _SimulationService_SetTickRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arena.proto",
@@ -29,6 +29,7 @@ const (
 	MatchStatus_WAITING                  MatchStatus = 1
 	MatchStatus_RUNNING                  MatchStatus = 2
 	MatchStatus_FINISHED                 MatchStatus = 3
+	MatchStatus_PAUSED                   MatchStatus = 4 // Frozen until resumed; advances only when stepped
 )
 
 // Enum value maps for MatchStatus.
@@ -38,12 +39,14 @@ var (
 		1: "WAITING",
 		2: "RUNNING",
 		3: "FINISHED",
+		4: "PAUSED",
 	}
 	MatchStatus_value = map[string]int32{
 		"MATCH_STATUS_UNSPECIFIED": 0,
 		"WAITING":                  1,
 		"RUNNING":                  2,
 		"FINISHED":                 3,
+		"PAUSED":                   4,
 	}
 )
 
@@ -81,6 +84,9 @@ const (
 	PowerType_SHIELD     PowerType = 1
 	PowerType_OVERCLOCK  PowerType = 2
 	PowerType_STEALTH    PowerType = 3
+	PowerType_REPAIR     PowerType = 4 // Restores hull at once
+	PowerType_EMP        PowerType = 5 // Drains the energy of nearby enemies
+	PowerType_DASH       PowerType = 6 // Short burst of speed
 )
 
 // Enum value maps for PowerType.
@@ -90,12 +96,18 @@ var (
 		1: "SHIELD",
 		2: "OVERCLOCK",
 		3: "STEALTH",
+		4: "REPAIR",
+		5: "EMP",
+		6: "DASH",
 	}
 	PowerType_value = map[string]int32{
 		"POWER_NONE": 0,
 		"SHIELD":     1,
 		"OVERCLOCK":  2,
 		"STEALTH":    3,
+		"REPAIR":     4,
+		"EMP":        5,
+		"DASH":       6,
 	}
 )
 
@@ -126,6 +138,59 @@ func (PowerType) EnumDescriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{1}
 }
 
+// Projectile kinds bots can fire
+type WeaponType int32
+
+const (
+	WeaponType_SHELL   WeaponType = 0 // Standard straight-line shell
+	WeaponType_LASER   WeaponType = 1 // Fast, low damage
+	WeaponType_MISSILE WeaponType = 2 // Slow, homes in on the nearest enemy
+	WeaponType_MINE    WeaponType = 3 // Stays where it was dropped until an enemy comes close
+)
+
+// Enum value maps for WeaponType.
+var (
+	WeaponType_name = map[int32]string{
+		0: "SHELL",
+		1: "LASER",
+		2: "MISSILE",
+		3: "MINE",
+	}
+	WeaponType_value = map[string]int32{
+		"SHELL":   0,
+		"LASER":   1,
+		"MISSILE": 2,
+		"MINE":    3,
+	}
+)
+
+func (x WeaponType) Enum() *WeaponType {
+	p := new(WeaponType)
+	*p = x
+	return p
+}
+
+func (x WeaponType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WeaponType) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_api_proto_enumTypes[2].Descriptor()
+}
+
+func (WeaponType) Type() protoreflect.EnumType {
+	return &file_bot_api_proto_enumTypes[2]
+}
+
+func (x WeaponType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WeaponType.Descriptor instead.
+func (WeaponType) EnumDescriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{2}
+}
+
 // Generic vector for 3D coordinates
 type Vector3 struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -187,42 +252,48 @@ func (x *Vector3) GetZ() float32 {
 	return 0
 }
 
-// Information about a bot in the arena
-type BotState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Position      *Vector3               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	Heading       float32                `protobuf:"fixed32,4,opt,name=heading,proto3" json:"heading,omitempty"`
-	GunHeading    float32                `protobuf:"fixed32,5,opt,name=gun_heading,json=gunHeading,proto3" json:"gun_heading,omitempty"`
-	RadarHeading  float32                `protobuf:"fixed32,6,opt,name=radar_heading,json=radarHeading,proto3" json:"radar_heading,omitempty"`
-	Velocity      float32                `protobuf:"fixed32,7,opt,name=velocity,proto3" json:"velocity,omitempty"`
-	Energy        float32                `protobuf:"fixed32,8,opt,name=energy,proto3" json:"energy,omitempty"`
-	Hull          float32                `protobuf:"fixed32,9,opt,name=hull,proto3" json:"hull,omitempty"`
-	Heat          float32                `protobuf:"fixed32,10,opt,name=heat,proto3" json:"heat,omitempty"`
-	ShieldHp      float32                `protobuf:"fixed32,11,opt,name=shield_hp,json=shieldHp,proto3" json:"shield_hp,omitempty"`                                                            // Remaining Shield points
-	IsStealthed   bool                   `protobuf:"varint,12,opt,name=is_stealthed,json=isStealthed,proto3" json:"is_stealthed,omitempty"`                                                    // If active stealth module
-	Cooldowns     map[string]int32       `protobuf:"bytes,13,rep,name=cooldowns,proto3" json:"cooldowns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Power-id to remaining ticks
-	Class         string                 `protobuf:"bytes,14,opt,name=class,proto3" json:"class,omitempty"`                                                                                    // Tank, Scout, Sniper
-	TeamId        string                 `protobuf:"bytes,15,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BotState) Reset() {
-	*x = BotState{}
+type ArenaConfig struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Width               float32                `protobuf:"fixed32,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height              float32                `protobuf:"fixed32,4,opt,name=height,proto3" json:"height,omitempty"`
+	Obstacles           []*Obstacle            `protobuf:"bytes,5,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
+	Zones               []*Zone                `protobuf:"bytes,6,rep,name=zones,proto3" json:"zones,omitempty"`
+	MaxBots             int32                  `protobuf:"varint,7,opt,name=max_bots,json=maxBots,proto3" json:"max_bots,omitempty"`
+	MatchDurationTicks  int64                  `protobuf:"varint,8,opt,name=match_duration_ticks,json=matchDurationTicks,proto3" json:"match_duration_ticks,omitempty"` // 0 = no time limit
+	WinCondition        string                 `protobuf:"bytes,9,opt,name=win_condition,json=winCondition,proto3" json:"win_condition,omitempty"`                      // LAST_BOT_STANDING (default), LAST_TEAM_STANDING, SCORE_TARGET
+	ScoreTarget         int32                  `protobuf:"varint,10,opt,name=score_target,json=scoreTarget,proto3" json:"score_target,omitempty"`                       // Kills needed to win with SCORE_TARGET
+	Tiebreak            string                 `protobuf:"bytes,11,opt,name=tiebreak,proto3" json:"tiebreak,omitempty"`                                                 // HULL (default), DAMAGE; decides time-limit finishes
+	TeamMode            bool                   `protobuf:"varint,12,opt,name=team_mode,json=teamMode,proto3" json:"team_mode,omitempty"`                                // Win conditions count teams instead of bots
+	FriendlyFire        bool                   `protobuf:"varint,13,opt,name=friendly_fire,json=friendlyFire,proto3" json:"friendly_fire,omitempty"`                    // Bullets damage teammates
+	Seed                int64                  `protobuf:"varint,14,opt,name=seed,proto3" json:"seed,omitempty"`                                                        // Seeds the match RNG; assigned by the engine when 0
+	ClassSet            string                 `protobuf:"bytes,15,opt,name=class_set,json=classSet,proto3" json:"class_set,omitempty"`                                 // Bot class stats to use; empty selects "default"
+	ZoneSchedule        *ZoneSchedule          `protobuf:"bytes,16,opt,name=zone_schedule,json=zoneSchedule,proto3" json:"zone_schedule,omitempty"`                     // Shrinking safe zone; none when unset
+	BulletCollisions    bool                   `protobuf:"varint,17,opt,name=bullet_collisions,json=bulletCollisions,proto3" json:"bullet_collisions,omitempty"`        // Bullets that meet in flight destroy each other
+	Pickups             []*PickupSpawn         `protobuf:"bytes,18,rep,name=pickups,proto3" json:"pickups,omitempty"`
+	SpawnPoints         []*SpawnPoint          `protobuf:"bytes,19,rep,name=spawn_points,json=spawnPoints,proto3" json:"spawn_points,omitempty"`                            // Generated from the seed when empty or all taken
+	BuiltinBots         []*BuiltinBot          `protobuf:"bytes,20,rep,name=builtin_bots,json=builtinBots,proto3" json:"builtin_bots,omitempty"`                            // In-process bots joined when the match is created
+	ReconnectGraceTicks int64                  `protobuf:"varint,21,opt,name=reconnect_grace_ticks,json=reconnectGraceTicks,proto3" json:"reconnect_grace_ticks,omitempty"` // Ticks a disconnected bot idles before it forfeits; 0 = default
+	IntentDeadline      *IntentDeadline        `protobuf:"bytes,22,opt,name=intent_deadline,json=intentDeadline,proto3" json:"intent_deadline,omitempty"`                   // Unset = intents apply whenever they arrive
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ArenaConfig) Reset() {
+	*x = ArenaConfig{}
 	mi := &file_bot_api_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BotState) String() string {
+func (x *ArenaConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BotState) ProtoMessage() {}
+func (*ArenaConfig) ProtoMessage() {}
 
-func (x *BotState) ProtoReflect() protoreflect.Message {
+func (x *ArenaConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -234,310 +305,251 @@ func (x *BotState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BotState.ProtoReflect.Descriptor instead.
-func (*BotState) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArenaConfig.ProtoReflect.Descriptor instead.
+func (*ArenaConfig) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *BotState) GetId() string {
+func (x *ArenaConfig) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *BotState) GetName() string {
+func (x *ArenaConfig) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BotState) GetPosition() *Vector3 {
-	if x != nil {
-		return x.Position
-	}
-	return nil
-}
-
-func (x *BotState) GetHeading() float32 {
+func (x *ArenaConfig) GetWidth() float32 {
 	if x != nil {
-		return x.Heading
+		return x.Width
 	}
 	return 0
 }
 
-func (x *BotState) GetGunHeading() float32 {
+func (x *ArenaConfig) GetHeight() float32 {
 	if x != nil {
-		return x.GunHeading
+		return x.Height
 	}
 	return 0
 }
 
-func (x *BotState) GetRadarHeading() float32 {
+func (x *ArenaConfig) GetObstacles() []*Obstacle {
 	if x != nil {
-		return x.RadarHeading
+		return x.Obstacles
 	}
-	return 0
+	return nil
 }
 
-func (x *BotState) GetVelocity() float32 {
+func (x *ArenaConfig) GetZones() []*Zone {
 	if x != nil {
-		return x.Velocity
+		return x.Zones
 	}
-	return 0
+	return nil
 }
 
-func (x *BotState) GetEnergy() float32 {
+func (x *ArenaConfig) GetMaxBots() int32 {
 	if x != nil {
-		return x.Energy
+		return x.MaxBots
 	}
 	return 0
 }
 
-func (x *BotState) GetHull() float32 {
+func (x *ArenaConfig) GetMatchDurationTicks() int64 {
 	if x != nil {
-		return x.Hull
+		return x.MatchDurationTicks
 	}
 	return 0
 }
 
-func (x *BotState) GetHeat() float32 {
+func (x *ArenaConfig) GetWinCondition() string {
 	if x != nil {
-		return x.Heat
+		return x.WinCondition
 	}
-	return 0
+	return ""
 }
 
-func (x *BotState) GetShieldHp() float32 {
+func (x *ArenaConfig) GetScoreTarget() int32 {
 	if x != nil {
-		return x.ShieldHp
+		return x.ScoreTarget
 	}
 	return 0
 }
 
-func (x *BotState) GetIsStealthed() bool {
-	if x != nil {
-		return x.IsStealthed
-	}
-	return false
-}
-
-func (x *BotState) GetCooldowns() map[string]int32 {
-	if x != nil {
-		return x.Cooldowns
-	}
-	return nil
-}
-
-func (x *BotState) GetClass() string {
+func (x *ArenaConfig) GetTiebreak() string {
 	if x != nil {
-		return x.Class
+		return x.Tiebreak
 	}
 	return ""
 }
 
-func (x *BotState) GetTeamId() string {
+func (x *ArenaConfig) GetTeamMode() bool {
 	if x != nil {
-		return x.TeamId
+		return x.TeamMode
 	}
-	return ""
-}
-
-// Event types emitted by the engine
-type SimulationEvent struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Tick  int64                  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
-	// Types that are valid to be assigned to Event:
-	//
-	//	*SimulationEvent_HitByBullet
-	//	*SimulationEvent_BulletHitTarget
-	//	*SimulationEvent_HitWall
-	//	*SimulationEvent_HitRobot
-	//	*SimulationEvent_ZoneEntered
-	//	*SimulationEvent_Death
-	//	*SimulationEvent_MatchFinished
-	Event         isSimulationEvent_Event `protobuf_oneof:"event"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SimulationEvent) Reset() {
-	*x = SimulationEvent{}
-	mi := &file_bot_api_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SimulationEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*SimulationEvent) ProtoMessage() {}
-
-func (x *SimulationEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_api_proto_msgTypes[2]
+func (x *ArenaConfig) GetFriendlyFire() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FriendlyFire
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SimulationEvent.ProtoReflect.Descriptor instead.
-func (*SimulationEvent) Descriptor() ([]byte, []int) {
-	return file_bot_api_proto_rawDescGZIP(), []int{2}
+	return false
 }
 
-func (x *SimulationEvent) GetTick() int64 {
+func (x *ArenaConfig) GetSeed() int64 {
 	if x != nil {
-		return x.Tick
+		return x.Seed
 	}
 	return 0
 }
 
-func (x *SimulationEvent) GetEvent() isSimulationEvent_Event {
+func (x *ArenaConfig) GetClassSet() string {
 	if x != nil {
-		return x.Event
+		return x.ClassSet
 	}
-	return nil
+	return ""
 }
 
-func (x *SimulationEvent) GetHitByBullet() *HitByBulletEvent {
+func (x *ArenaConfig) GetZoneSchedule() *ZoneSchedule {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_HitByBullet); ok {
-			return x.HitByBullet
-		}
+		return x.ZoneSchedule
 	}
 	return nil
 }
 
-func (x *SimulationEvent) GetBulletHitTarget() *BulletHitTargetEvent {
+func (x *ArenaConfig) GetBulletCollisions() bool {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_BulletHitTarget); ok {
-			return x.BulletHitTarget
-		}
+		return x.BulletCollisions
 	}
-	return nil
+	return false
 }
 
-func (x *SimulationEvent) GetHitWall() *HitWallEvent {
+func (x *ArenaConfig) GetPickups() []*PickupSpawn {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_HitWall); ok {
-			return x.HitWall
-		}
+		return x.Pickups
 	}
 	return nil
 }
 
-func (x *SimulationEvent) GetHitRobot() *HitRobotEvent {
+func (x *ArenaConfig) GetSpawnPoints() []*SpawnPoint {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_HitRobot); ok {
-			return x.HitRobot
-		}
+		return x.SpawnPoints
 	}
 	return nil
 }
 
-func (x *SimulationEvent) GetZoneEntered() *ZoneEnteredEvent {
+func (x *ArenaConfig) GetBuiltinBots() []*BuiltinBot {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_ZoneEntered); ok {
-			return x.ZoneEntered
-		}
+		return x.BuiltinBots
 	}
 	return nil
 }
 
-func (x *SimulationEvent) GetDeath() *DeathEvent {
+func (x *ArenaConfig) GetReconnectGraceTicks() int64 {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_Death); ok {
-			return x.Death
-		}
+		return x.ReconnectGraceTicks
 	}
-	return nil
+	return 0
 }
 
-func (x *SimulationEvent) GetMatchFinished() *MatchFinishedEvent {
+func (x *ArenaConfig) GetIntentDeadline() *IntentDeadline {
 	if x != nil {
-		if x, ok := x.Event.(*SimulationEvent_MatchFinished); ok {
-			return x.MatchFinished
-		}
+		return x.IntentDeadline
 	}
 	return nil
 }
 
-type isSimulationEvent_Event interface {
-	isSimulationEvent_Event()
+// How late a networked bot may answer a state, and what happens when it is
+type IntentDeadline struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Ticks           int64                  `protobuf:"varint,1,opt,name=ticks,proto3" json:"ticks,omitempty"`                                              // Intents answering a state more than this many ticks old are stale
+	OnMiss          string                 `protobuf:"bytes,2,opt,name=on_miss,json=onMiss,proto3" json:"on_miss,omitempty"`                               // SKIP (default): the bot gets no intent that tick; REUSE: its last intent repeats
+	MaxSkippedTurns int32                  `protobuf:"varint,3,opt,name=max_skipped_turns,json=maxSkippedTurns,proto3" json:"max_skipped_turns,omitempty"` // Turns without an intent in time before the bot is disqualified; 0 = never
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-type SimulationEvent_HitByBullet struct {
-	HitByBullet *HitByBulletEvent `protobuf:"bytes,2,opt,name=hit_by_bullet,json=hitByBullet,proto3,oneof"`
+func (x *IntentDeadline) Reset() {
+	*x = IntentDeadline{}
+	mi := &file_bot_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type SimulationEvent_BulletHitTarget struct {
-	BulletHitTarget *BulletHitTargetEvent `protobuf:"bytes,3,opt,name=bullet_hit_target,json=bulletHitTarget,proto3,oneof"`
+func (x *IntentDeadline) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type SimulationEvent_HitWall struct {
-	HitWall *HitWallEvent `protobuf:"bytes,4,opt,name=hit_wall,json=hitWall,proto3,oneof"`
-}
+func (*IntentDeadline) ProtoMessage() {}
 
-type SimulationEvent_HitRobot struct {
-	HitRobot *HitRobotEvent `protobuf:"bytes,5,opt,name=hit_robot,json=hitRobot,proto3,oneof"`
+func (x *IntentDeadline) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type SimulationEvent_ZoneEntered struct {
-	ZoneEntered *ZoneEnteredEvent `protobuf:"bytes,6,opt,name=zone_entered,json=zoneEntered,proto3,oneof"`
+// Deprecated: Use IntentDeadline.ProtoReflect.Descriptor instead.
+func (*IntentDeadline) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{2}
 }
 
-type SimulationEvent_Death struct {
-	Death *DeathEvent `protobuf:"bytes,7,opt,name=death,proto3,oneof"`
+func (x *IntentDeadline) GetTicks() int64 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
 }
 
-type SimulationEvent_MatchFinished struct {
-	MatchFinished *MatchFinishedEvent `protobuf:"bytes,8,opt,name=match_finished,json=matchFinished,proto3,oneof"`
+func (x *IntentDeadline) GetOnMiss() string {
+	if x != nil {
+		return x.OnMiss
+	}
+	return ""
 }
 
-func (*SimulationEvent_HitByBullet) isSimulationEvent_Event() {}
-
-func (*SimulationEvent_BulletHitTarget) isSimulationEvent_Event() {}
-
-func (*SimulationEvent_HitWall) isSimulationEvent_Event() {}
-
-func (*SimulationEvent_HitRobot) isSimulationEvent_Event() {}
-
-func (*SimulationEvent_ZoneEntered) isSimulationEvent_Event() {}
-
-func (*SimulationEvent_Death) isSimulationEvent_Event() {}
-
-func (*SimulationEvent_MatchFinished) isSimulationEvent_Event() {}
+func (x *IntentDeadline) GetMaxSkippedTurns() int32 {
+	if x != nil {
+		return x.MaxSkippedTurns
+	}
+	return 0
+}
 
-type HitByBulletEvent struct {
+// A bot the engine plays itself, without a network connection
+type BuiltinBot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VictimId      string                 `protobuf:"bytes,1,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"`
-	BulletId      string                 `protobuf:"bytes,2,opt,name=bullet_id,json=bulletId,proto3" json:"bullet_id,omitempty"`
-	Damage        float32                `protobuf:"fixed32,3,opt,name=damage,proto3" json:"damage,omitempty"`
+	Ai            string                 `protobuf:"bytes,1,opt,name=ai,proto3" json:"ai,omitempty"`       // Registered AI, e.g. "spinner" or "sniper"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // Defaults to the AI name
+	Class         string                 `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"` // Empty selects a Tank
+	TeamId        string                 `protobuf:"bytes,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HitByBulletEvent) Reset() {
-	*x = HitByBulletEvent{}
+func (x *BuiltinBot) Reset() {
+	*x = BuiltinBot{}
 	mi := &file_bot_api_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HitByBulletEvent) String() string {
+func (x *BuiltinBot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HitByBulletEvent) ProtoMessage() {}
+func (*BuiltinBot) ProtoMessage() {}
 
-func (x *HitByBulletEvent) ProtoReflect() protoreflect.Message {
+func (x *BuiltinBot) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,54 +561,63 @@ func (x *HitByBulletEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HitByBulletEvent.ProtoReflect.Descriptor instead.
-func (*HitByBulletEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuiltinBot.ProtoReflect.Descriptor instead.
+func (*BuiltinBot) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *HitByBulletEvent) GetVictimId() string {
+func (x *BuiltinBot) GetAi() string {
 	if x != nil {
-		return x.VictimId
+		return x.Ai
 	}
 	return ""
 }
 
-func (x *HitByBulletEvent) GetBulletId() string {
+func (x *BuiltinBot) GetName() string {
 	if x != nil {
-		return x.BulletId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *HitByBulletEvent) GetDamage() float32 {
+func (x *BuiltinBot) GetClass() string {
 	if x != nil {
-		return x.Damage
+		return x.Class
 	}
-	return 0
+	return ""
 }
 
-type BulletHitTargetEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BulletId      string                 `protobuf:"bytes,1,opt,name=bullet_id,json=bulletId,proto3" json:"bullet_id,omitempty"`
-	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+func (x *BuiltinBot) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+// A starting position for bots
+type SpawnPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      *Vector3               `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Heading       float32                `protobuf:"fixed32,2,opt,name=heading,proto3" json:"heading,omitempty"`           // Initial heading in degrees
+	TeamId        string                 `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Reserved for this team; empty = any bot
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulletHitTargetEvent) Reset() {
-	*x = BulletHitTargetEvent{}
+func (x *SpawnPoint) Reset() {
+	*x = SpawnPoint{}
 	mi := &file_bot_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulletHitTargetEvent) String() string {
+func (x *SpawnPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulletHitTargetEvent) ProtoMessage() {}
+func (*SpawnPoint) ProtoMessage() {}
 
-func (x *BulletHitTargetEvent) ProtoReflect() protoreflect.Message {
+func (x *SpawnPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -608,46 +629,58 @@ func (x *BulletHitTargetEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulletHitTargetEvent.ProtoReflect.Descriptor instead.
-func (*BulletHitTargetEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpawnPoint.ProtoReflect.Descriptor instead.
+func (*SpawnPoint) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BulletHitTargetEvent) GetBulletId() string {
+func (x *SpawnPoint) GetPosition() *Vector3 {
 	if x != nil {
-		return x.BulletId
+		return x.Position
 	}
-	return ""
+	return nil
 }
 
-func (x *BulletHitTargetEvent) GetTargetId() string {
+func (x *SpawnPoint) GetHeading() float32 {
 	if x != nil {
-		return x.TargetId
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *SpawnPoint) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
 	}
 	return ""
 }
 
-type HitWallEvent struct {
+// A spot where a pickup appears and, once collected, respawns
+type PickupSpawn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position      *Vector3               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                      // HEALTH, ENERGY, AMMO
+	Amount        float32                `protobuf:"fixed32,4,opt,name=amount,proto3" json:"amount,omitempty"`                                // 0 = the type's default
+	RespawnTicks  int64                  `protobuf:"varint,5,opt,name=respawn_ticks,json=respawnTicks,proto3" json:"respawn_ticks,omitempty"` // 0 = never respawns
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HitWallEvent) Reset() {
-	*x = HitWallEvent{}
+func (x *PickupSpawn) Reset() {
+	*x = PickupSpawn{}
 	mi := &file_bot_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HitWallEvent) String() string {
+func (x *PickupSpawn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HitWallEvent) ProtoMessage() {}
+func (*PickupSpawn) ProtoMessage() {}
 
-func (x *HitWallEvent) ProtoReflect() protoreflect.Message {
+func (x *PickupSpawn) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -659,40 +692,70 @@ func (x *HitWallEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HitWallEvent.ProtoReflect.Descriptor instead.
-func (*HitWallEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use PickupSpawn.ProtoReflect.Descriptor instead.
+func (*PickupSpawn) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *HitWallEvent) GetBotId() string {
+func (x *PickupSpawn) GetId() string {
 	if x != nil {
-		return x.BotId
+		return x.Id
 	}
 	return ""
 }
 
-type HitRobotEvent struct {
+func (x *PickupSpawn) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PickupSpawn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PickupSpawn) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PickupSpawn) GetRespawnTicks() int64 {
+	if x != nil {
+		return x.RespawnTicks
+	}
+	return 0
+}
+
+// Battle-royale safe zone: a circle that shrinks through scripted phases
+type ZoneSchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
-	OtherId       string                 `protobuf:"bytes,2,opt,name=other_id,json=otherId,proto3" json:"other_id,omitempty"`
+	Center        *Vector3               `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`                                // Defaults to the arena center
+	StartRadius   float32                `protobuf:"fixed32,2,opt,name=start_radius,json=startRadius,proto3" json:"start_radius,omitempty"` // Defaults to covering the whole arena
+	Phases        []*ZonePhase           `protobuf:"bytes,3,rep,name=phases,proto3" json:"phases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HitRobotEvent) Reset() {
-	*x = HitRobotEvent{}
+func (x *ZoneSchedule) Reset() {
+	*x = ZoneSchedule{}
 	mi := &file_bot_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HitRobotEvent) String() string {
+func (x *ZoneSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HitRobotEvent) ProtoMessage() {}
+func (*ZoneSchedule) ProtoMessage() {}
 
-func (x *HitRobotEvent) ProtoReflect() protoreflect.Message {
+func (x *ZoneSchedule) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,48 +767,57 @@ func (x *HitRobotEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HitRobotEvent.ProtoReflect.Descriptor instead.
-func (*HitRobotEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ZoneSchedule.ProtoReflect.Descriptor instead.
+func (*ZoneSchedule) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *HitRobotEvent) GetBotId() string {
+func (x *ZoneSchedule) GetCenter() *Vector3 {
 	if x != nil {
-		return x.BotId
+		return x.Center
 	}
-	return ""
+	return nil
 }
 
-func (x *HitRobotEvent) GetOtherId() string {
+func (x *ZoneSchedule) GetStartRadius() float32 {
 	if x != nil {
-		return x.OtherId
+		return x.StartRadius
 	}
-	return ""
+	return 0
 }
 
-type ZoneEnteredEvent struct {
+func (x *ZoneSchedule) GetPhases() []*ZonePhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+type ZonePhase struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
-	ZoneId        string                 `protobuf:"bytes,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	WaitTicks     int64                  `protobuf:"varint,1,opt,name=wait_ticks,json=waitTicks,proto3" json:"wait_ticks,omitempty"`       // Hold before shrinking
+	ShrinkTicks   int64                  `protobuf:"varint,2,opt,name=shrink_ticks,json=shrinkTicks,proto3" json:"shrink_ticks,omitempty"` // Time to reach the target circle
+	TargetRadius  float32                `protobuf:"fixed32,3,opt,name=target_radius,json=targetRadius,proto3" json:"target_radius,omitempty"`
+	MaxDrift      float32                `protobuf:"fixed32,4,opt,name=max_drift,json=maxDrift,proto3" json:"max_drift,omitempty"` // How far the center may move, picked from the match seed
+	Damage        float32                `protobuf:"fixed32,5,opt,name=damage,proto3" json:"damage,omitempty"`                     // Per tick outside the zone from the start of this phase
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ZoneEnteredEvent) Reset() {
-	*x = ZoneEnteredEvent{}
+func (x *ZonePhase) Reset() {
+	*x = ZonePhase{}
 	mi := &file_bot_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ZoneEnteredEvent) String() string {
+func (x *ZonePhase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ZoneEnteredEvent) ProtoMessage() {}
+func (*ZonePhase) ProtoMessage() {}
 
-func (x *ZoneEnteredEvent) ProtoReflect() protoreflect.Message {
+func (x *ZonePhase) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -757,54 +829,71 @@ func (x *ZoneEnteredEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ZoneEnteredEvent.ProtoReflect.Descriptor instead.
-func (*ZoneEnteredEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ZonePhase.ProtoReflect.Descriptor instead.
+func (*ZonePhase) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ZoneEnteredEvent) GetBotId() string {
+func (x *ZonePhase) GetWaitTicks() int64 {
 	if x != nil {
-		return x.BotId
+		return x.WaitTicks
 	}
-	return ""
+	return 0
 }
 
-func (x *ZoneEnteredEvent) GetZoneId() string {
+func (x *ZonePhase) GetShrinkTicks() int64 {
 	if x != nil {
-		return x.ZoneId
+		return x.ShrinkTicks
 	}
-	return ""
+	return 0
 }
 
-func (x *ZoneEnteredEvent) GetType() string {
+func (x *ZonePhase) GetTargetRadius() float32 {
 	if x != nil {
-		return x.Type
+		return x.TargetRadius
 	}
-	return ""
+	return 0
 }
 
-type DeathEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
-	KillerId      string                 `protobuf:"bytes,2,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ZonePhase) GetMaxDrift() float32 {
+	if x != nil {
+		return x.MaxDrift
+	}
+	return 0
 }
 
-func (x *DeathEvent) Reset() {
-	*x = DeathEvent{}
+func (x *ZonePhase) GetDamage() float32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+type Obstacle struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position       *Vector3               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Radius         float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"` // All obstacles are circular in v1
+	Indestructible bool                   `protobuf:"varint,4,opt,name=indestructible,proto3" json:"indestructible,omitempty"`
+	Hp             float32                `protobuf:"fixed32,5,opt,name=hp,proto3" json:"hp,omitempty"` // Starting hit points if destructible (0 = default)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Obstacle) Reset() {
+	*x = Obstacle{}
 	mi := &file_bot_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeathEvent) String() string {
+func (x *Obstacle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeathEvent) ProtoMessage() {}
+func (*Obstacle) ProtoMessage() {}
 
-func (x *DeathEvent) ProtoReflect() protoreflect.Message {
+func (x *Obstacle) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -816,46 +905,71 @@ func (x *DeathEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeathEvent.ProtoReflect.Descriptor instead.
-func (*DeathEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use Obstacle.ProtoReflect.Descriptor instead.
+func (*Obstacle) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeathEvent) GetBotId() string {
+func (x *Obstacle) GetId() string {
 	if x != nil {
-		return x.BotId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeathEvent) GetKillerId() string {
+func (x *Obstacle) GetPosition() *Vector3 {
 	if x != nil {
-		return x.KillerId
+		return x.Position
 	}
-	return ""
+	return nil
 }
 
-type MatchFinishedEvent struct {
+func (x *Obstacle) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Obstacle) GetIndestructible() bool {
+	if x != nil {
+		return x.Indestructible
+	}
+	return false
+}
+
+func (x *Obstacle) GetHp() float32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+type Zone struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position      *Vector3               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Radius        float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`             // HEAL, ENERGY, HAZARD, SPEED_BOOST, SLOW, RADAR_JAM
+	Intensity     float32                `protobuf:"fixed32,5,opt,name=intensity,proto3" json:"intensity,omitempty"` // Scales the zone's effect; 0 counts as 1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchFinishedEvent) Reset() {
-	*x = MatchFinishedEvent{}
+func (x *Zone) Reset() {
+	*x = Zone{}
 	mi := &file_bot_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchFinishedEvent) String() string {
+func (x *Zone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchFinishedEvent) ProtoMessage() {}
+func (*Zone) ProtoMessage() {}
 
-func (x *MatchFinishedEvent) ProtoReflect() protoreflect.Message {
+func (x *Zone) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -867,44 +981,88 @@ func (x *MatchFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchFinishedEvent.ProtoReflect.Descriptor instead.
-func (*MatchFinishedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
+func (*Zone) Descriptor() ([]byte, []int) {
 	return file_bot_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MatchFinishedEvent) GetWinnerId() string {
+func (x *Zone) GetId() string {
 	if x != nil {
-		return x.WinnerId
+		return x.Id
 	}
 	return ""
 }
 
-type BulletState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Position      *Vector3               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	Heading       float32                `protobuf:"fixed32,4,opt,name=heading,proto3" json:"heading,omitempty"`
-	Velocity      float32                `protobuf:"fixed32,5,opt,name=velocity,proto3" json:"velocity,omitempty"`
-	Power         float32                `protobuf:"fixed32,6,opt,name=power,proto3" json:"power,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Zone) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
 }
 
-func (x *BulletState) Reset() {
-	*x = BulletState{}
+func (x *Zone) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Zone) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Zone) GetIntensity() float32 {
+	if x != nil {
+		return x.Intensity
+	}
+	return 0
+}
+
+// Information about a bot in the arena
+type BotState struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position     *Vector3               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Heading      float32                `protobuf:"fixed32,4,opt,name=heading,proto3" json:"heading,omitempty"`
+	GunHeading   float32                `protobuf:"fixed32,5,opt,name=gun_heading,json=gunHeading,proto3" json:"gun_heading,omitempty"`
+	RadarHeading float32                `protobuf:"fixed32,6,opt,name=radar_heading,json=radarHeading,proto3" json:"radar_heading,omitempty"`
+	Velocity     float32                `protobuf:"fixed32,7,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Energy       float32                `protobuf:"fixed32,8,opt,name=energy,proto3" json:"energy,omitempty"`
+	Hull         float32                `protobuf:"fixed32,9,opt,name=hull,proto3" json:"hull,omitempty"`
+	Heat         float32                `protobuf:"fixed32,10,opt,name=heat,proto3" json:"heat,omitempty"`
+	ShieldHp     float32                `protobuf:"fixed32,11,opt,name=shield_hp,json=shieldHp,proto3" json:"shield_hp,omitempty"`         // Remaining Shield points
+	IsStealthed  bool                   `protobuf:"varint,12,opt,name=is_stealthed,json=isStealthed,proto3" json:"is_stealthed,omitempty"` // If active stealth module
+	// Deprecated: Marked as deprecated in bot_api.proto.
+	Cooldowns         map[string]int32 `protobuf:"bytes,13,rep,name=cooldowns,proto3" json:"cooldowns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Replaced by powers
+	Class             string           `protobuf:"bytes,14,opt,name=class,proto3" json:"class,omitempty"`                                                                                    // Tank, Scout, Sniper
+	TeamId            string           `protobuf:"bytes,15,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ZoneIds           []string         `protobuf:"bytes,16,rep,name=zone_ids,json=zoneIds,proto3" json:"zone_ids,omitempty"`                                 // Arena zones the bot is inside
+	RadarJammed       bool             `protobuf:"varint,17,opt,name=radar_jammed,json=radarJammed,proto3" json:"radar_jammed,omitempty"`                    // Inside a radar-jamming zone
+	Weapons           []WeaponType     `protobuf:"varint,18,rep,packed,name=weapons,proto3,enum=codearena.v1.WeaponType" json:"weapons,omitempty"`           // Weapons the bot's class can fire
+	DistanceRemaining float32          `protobuf:"fixed32,19,opt,name=distance_remaining,json=distanceRemaining,proto3" json:"distance_remaining,omitempty"` // Left of the last move_distance; + forward, - backward
+	Powers            []*PowerState    `protobuf:"bytes,20,rep,name=powers,proto3" json:"powers,omitempty"`                                                  // Powers cooling down or active
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BotState) Reset() {
+	*x = BotState{}
 	mi := &file_bot_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulletState) String() string {
+func (x *BotState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulletState) ProtoMessage() {}
+func (*BotState) ProtoMessage() {}
 
-func (x *BulletState) ProtoReflect() protoreflect.Message {
+func (x *BotState) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,80 +1071,2069 @@ func (x *BulletState) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotState.ProtoReflect.Descriptor instead.
+func (*BotState) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BotState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BotState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BotState) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *BotState) GetHeading() float32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *BotState) GetGunHeading() float32 {
+	if x != nil {
+		return x.GunHeading
+	}
+	return 0
+}
+
+func (x *BotState) GetRadarHeading() float32 {
+	if x != nil {
+		return x.RadarHeading
+	}
+	return 0
+}
+
+func (x *BotState) GetVelocity() float32 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *BotState) GetEnergy() float32 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *BotState) GetHull() float32 {
+	if x != nil {
+		return x.Hull
+	}
+	return 0
+}
+
+func (x *BotState) GetHeat() float32 {
+	if x != nil {
+		return x.Heat
+	}
+	return 0
+}
+
+func (x *BotState) GetShieldHp() float32 {
+	if x != nil {
+		return x.ShieldHp
+	}
+	return 0
+}
+
+func (x *BotState) GetIsStealthed() bool {
+	if x != nil {
+		return x.IsStealthed
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in bot_api.proto.
+func (x *BotState) GetCooldowns() map[string]int32 {
+	if x != nil {
+		return x.Cooldowns
+	}
+	return nil
+}
+
+func (x *BotState) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *BotState) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *BotState) GetZoneIds() []string {
+	if x != nil {
+		return x.ZoneIds
+	}
+	return nil
+}
+
+func (x *BotState) GetRadarJammed() bool {
+	if x != nil {
+		return x.RadarJammed
+	}
+	return false
+}
+
+func (x *BotState) GetWeapons() []WeaponType {
+	if x != nil {
+		return x.Weapons
+	}
+	return nil
+}
+
+func (x *BotState) GetDistanceRemaining() float32 {
+	if x != nil {
+		return x.DistanceRemaining
+	}
+	return 0
+}
+
+func (x *BotState) GetPowers() []*PowerState {
+	if x != nil {
+		return x.Powers
+	}
+	return nil
+}
+
+type PowerState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PowerType              `protobuf:"varint,1,opt,name=type,proto3,enum=codearena.v1.PowerType" json:"type,omitempty"`
+	Cooldown      int32                  `protobuf:"varint,2,opt,name=cooldown,proto3" json:"cooldown,omitempty"`                          // Ticks until it can be used again
+	ActiveTicks   int32                  `protobuf:"varint,3,opt,name=active_ticks,json=activeTicks,proto3" json:"active_ticks,omitempty"` // Ticks the effect has left; 0 once expired or for instant powers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerState) Reset() {
+	*x = PowerState{}
+	mi := &file_bot_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerState) ProtoMessage() {}
+
+func (x *PowerState) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerState.ProtoReflect.Descriptor instead.
+func (*PowerState) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PowerState) GetType() PowerType {
+	if x != nil {
+		return x.Type
+	}
+	return PowerType_POWER_NONE
+}
+
+func (x *PowerState) GetCooldown() int32 {
+	if x != nil {
+		return x.Cooldown
+	}
+	return 0
+}
+
+func (x *PowerState) GetActiveTicks() int32 {
+	if x != nil {
+		return x.ActiveTicks
+	}
+	return 0
+}
+
+// Event types emitted by the engine
+type SimulationEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tick  int64                  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*SimulationEvent_HitByBullet
+	//	*SimulationEvent_BulletHitTarget
+	//	*SimulationEvent_HitWall
+	//	*SimulationEvent_HitRobot
+	//	*SimulationEvent_ZoneEntered
+	//	*SimulationEvent_Death
+	//	*SimulationEvent_MatchFinished
+	//	*SimulationEvent_ObstacleDestroyed
+	//	*SimulationEvent_ScannedBot
+	//	*SimulationEvent_TeamMessage
+	//	*SimulationEvent_ZoneExited
+	//	*SimulationEvent_BulletHitBullet
+	//	*SimulationEvent_IntentWarning
+	//	*SimulationEvent_PowerActivated
+	//	*SimulationEvent_PowerExpired
+	//	*SimulationEvent_PickupCollected
+	//	*SimulationEvent_BotDisconnected
+	//	*SimulationEvent_BotReconnected
+	Event         isSimulationEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationEvent) Reset() {
+	*x = SimulationEvent{}
+	mi := &file_bot_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationEvent) ProtoMessage() {}
+
+func (x *SimulationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationEvent.ProtoReflect.Descriptor instead.
+func (*SimulationEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SimulationEvent) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *SimulationEvent) GetEvent() isSimulationEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetHitByBullet() *HitByBulletEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_HitByBullet); ok {
+			return x.HitByBullet
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetBulletHitTarget() *BulletHitTargetEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_BulletHitTarget); ok {
+			return x.BulletHitTarget
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetHitWall() *HitWallEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_HitWall); ok {
+			return x.HitWall
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetHitRobot() *HitRobotEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_HitRobot); ok {
+			return x.HitRobot
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetZoneEntered() *ZoneEnteredEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_ZoneEntered); ok {
+			return x.ZoneEntered
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetDeath() *DeathEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_Death); ok {
+			return x.Death
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetMatchFinished() *MatchFinishedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_MatchFinished); ok {
+			return x.MatchFinished
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetObstacleDestroyed() *ObstacleDestroyedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_ObstacleDestroyed); ok {
+			return x.ObstacleDestroyed
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetScannedBot() *ScannedBotEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_ScannedBot); ok {
+			return x.ScannedBot
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetTeamMessage() *TeamMessageEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_TeamMessage); ok {
+			return x.TeamMessage
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetZoneExited() *ZoneExitedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_ZoneExited); ok {
+			return x.ZoneExited
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetBulletHitBullet() *BulletHitBulletEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_BulletHitBullet); ok {
+			return x.BulletHitBullet
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetIntentWarning() *IntentWarningEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_IntentWarning); ok {
+			return x.IntentWarning
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetPowerActivated() *PowerActivatedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_PowerActivated); ok {
+			return x.PowerActivated
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetPowerExpired() *PowerExpiredEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_PowerExpired); ok {
+			return x.PowerExpired
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetPickupCollected() *PickupCollectedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_PickupCollected); ok {
+			return x.PickupCollected
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetBotDisconnected() *BotDisconnectedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_BotDisconnected); ok {
+			return x.BotDisconnected
+		}
+	}
+	return nil
+}
+
+func (x *SimulationEvent) GetBotReconnected() *BotReconnectedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SimulationEvent_BotReconnected); ok {
+			return x.BotReconnected
+		}
+	}
+	return nil
+}
+
+type isSimulationEvent_Event interface {
+	isSimulationEvent_Event()
+}
+
+type SimulationEvent_HitByBullet struct {
+	HitByBullet *HitByBulletEvent `protobuf:"bytes,2,opt,name=hit_by_bullet,json=hitByBullet,proto3,oneof"`
+}
+
+type SimulationEvent_BulletHitTarget struct {
+	BulletHitTarget *BulletHitTargetEvent `protobuf:"bytes,3,opt,name=bullet_hit_target,json=bulletHitTarget,proto3,oneof"`
+}
+
+type SimulationEvent_HitWall struct {
+	HitWall *HitWallEvent `protobuf:"bytes,4,opt,name=hit_wall,json=hitWall,proto3,oneof"`
+}
+
+type SimulationEvent_HitRobot struct {
+	HitRobot *HitRobotEvent `protobuf:"bytes,5,opt,name=hit_robot,json=hitRobot,proto3,oneof"`
+}
+
+type SimulationEvent_ZoneEntered struct {
+	ZoneEntered *ZoneEnteredEvent `protobuf:"bytes,6,opt,name=zone_entered,json=zoneEntered,proto3,oneof"`
+}
+
+type SimulationEvent_Death struct {
+	Death *DeathEvent `protobuf:"bytes,7,opt,name=death,proto3,oneof"`
+}
+
+type SimulationEvent_MatchFinished struct {
+	MatchFinished *MatchFinishedEvent `protobuf:"bytes,8,opt,name=match_finished,json=matchFinished,proto3,oneof"`
+}
+
+type SimulationEvent_ObstacleDestroyed struct {
+	ObstacleDestroyed *ObstacleDestroyedEvent `protobuf:"bytes,9,opt,name=obstacle_destroyed,json=obstacleDestroyed,proto3,oneof"`
+}
+
+type SimulationEvent_ScannedBot struct {
+	ScannedBot *ScannedBotEvent `protobuf:"bytes,10,opt,name=scanned_bot,json=scannedBot,proto3,oneof"`
+}
+
+type SimulationEvent_TeamMessage struct {
+	TeamMessage *TeamMessageEvent `protobuf:"bytes,11,opt,name=team_message,json=teamMessage,proto3,oneof"`
+}
+
+type SimulationEvent_ZoneExited struct {
+	ZoneExited *ZoneExitedEvent `protobuf:"bytes,12,opt,name=zone_exited,json=zoneExited,proto3,oneof"`
+}
+
+type SimulationEvent_BulletHitBullet struct {
+	BulletHitBullet *BulletHitBulletEvent `protobuf:"bytes,13,opt,name=bullet_hit_bullet,json=bulletHitBullet,proto3,oneof"`
+}
+
+type SimulationEvent_IntentWarning struct {
+	IntentWarning *IntentWarningEvent `protobuf:"bytes,14,opt,name=intent_warning,json=intentWarning,proto3,oneof"`
+}
+
+type SimulationEvent_PowerActivated struct {
+	PowerActivated *PowerActivatedEvent `protobuf:"bytes,15,opt,name=power_activated,json=powerActivated,proto3,oneof"`
+}
+
+type SimulationEvent_PowerExpired struct {
+	PowerExpired *PowerExpiredEvent `protobuf:"bytes,16,opt,name=power_expired,json=powerExpired,proto3,oneof"`
+}
+
+type SimulationEvent_PickupCollected struct {
+	PickupCollected *PickupCollectedEvent `protobuf:"bytes,17,opt,name=pickup_collected,json=pickupCollected,proto3,oneof"`
+}
+
+type SimulationEvent_BotDisconnected struct {
+	BotDisconnected *BotDisconnectedEvent `protobuf:"bytes,18,opt,name=bot_disconnected,json=botDisconnected,proto3,oneof"`
+}
+
+type SimulationEvent_BotReconnected struct {
+	BotReconnected *BotReconnectedEvent `protobuf:"bytes,19,opt,name=bot_reconnected,json=botReconnected,proto3,oneof"`
+}
+
+func (*SimulationEvent_HitByBullet) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_BulletHitTarget) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_HitWall) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_HitRobot) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_ZoneEntered) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_Death) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_MatchFinished) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_ObstacleDestroyed) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_ScannedBot) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_TeamMessage) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_ZoneExited) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_BulletHitBullet) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_IntentWarning) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_PowerActivated) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_PowerExpired) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_PickupCollected) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_BotDisconnected) isSimulationEvent_Event() {}
+
+func (*SimulationEvent_BotReconnected) isSimulationEvent_Event() {}
+
+type HitByBulletEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VictimId      string                 `protobuf:"bytes,1,opt,name=victim_id,json=victimId,proto3" json:"victim_id,omitempty"`
+	BulletId      string                 `protobuf:"bytes,2,opt,name=bullet_id,json=bulletId,proto3" json:"bullet_id,omitempty"`
+	Damage        float32                `protobuf:"fixed32,3,opt,name=damage,proto3" json:"damage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HitByBulletEvent) Reset() {
+	*x = HitByBulletEvent{}
+	mi := &file_bot_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HitByBulletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HitByBulletEvent) ProtoMessage() {}
+
+func (x *HitByBulletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HitByBulletEvent.ProtoReflect.Descriptor instead.
+func (*HitByBulletEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *HitByBulletEvent) GetVictimId() string {
+	if x != nil {
+		return x.VictimId
+	}
+	return ""
+}
+
+func (x *HitByBulletEvent) GetBulletId() string {
+	if x != nil {
+		return x.BulletId
+	}
+	return ""
+}
+
+func (x *HitByBulletEvent) GetDamage() float32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+type BulletHitTargetEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BulletId      string                 `protobuf:"bytes,1,opt,name=bullet_id,json=bulletId,proto3" json:"bullet_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Damage        float32                `protobuf:"fixed32,4,opt,name=damage,proto3" json:"damage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulletHitTargetEvent) Reset() {
+	*x = BulletHitTargetEvent{}
+	mi := &file_bot_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulletHitTargetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulletHitTargetEvent) ProtoMessage() {}
+
+func (x *BulletHitTargetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulletHitTargetEvent.ProtoReflect.Descriptor instead.
+func (*BulletHitTargetEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BulletHitTargetEvent) GetBulletId() string {
+	if x != nil {
+		return x.BulletId
+	}
+	return ""
+}
+
+func (x *BulletHitTargetEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *BulletHitTargetEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *BulletHitTargetEvent) GetDamage() float32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+type HitWallEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HitWallEvent) Reset() {
+	*x = HitWallEvent{}
+	mi := &file_bot_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HitWallEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HitWallEvent) ProtoMessage() {}
+
+func (x *HitWallEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HitWallEvent.ProtoReflect.Descriptor instead.
+func (*HitWallEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HitWallEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type BulletHitBulletEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BulletId      string                 `protobuf:"bytes,1,opt,name=bullet_id,json=bulletId,proto3" json:"bullet_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OtherBulletId string                 `protobuf:"bytes,3,opt,name=other_bullet_id,json=otherBulletId,proto3" json:"other_bullet_id,omitempty"`
+	OtherOwnerId  string                 `protobuf:"bytes,4,opt,name=other_owner_id,json=otherOwnerId,proto3" json:"other_owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulletHitBulletEvent) Reset() {
+	*x = BulletHitBulletEvent{}
+	mi := &file_bot_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulletHitBulletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulletHitBulletEvent) ProtoMessage() {}
+
+func (x *BulletHitBulletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulletHitBulletEvent.ProtoReflect.Descriptor instead.
+func (*BulletHitBulletEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BulletHitBulletEvent) GetBulletId() string {
+	if x != nil {
+		return x.BulletId
+	}
+	return ""
+}
+
+func (x *BulletHitBulletEvent) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *BulletHitBulletEvent) GetOtherBulletId() string {
+	if x != nil {
+		return x.OtherBulletId
+	}
+	return ""
+}
+
+func (x *BulletHitBulletEvent) GetOtherOwnerId() string {
+	if x != nil {
+		return x.OtherOwnerId
+	}
+	return ""
+}
+
+type HitRobotEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	OtherId       string                 `protobuf:"bytes,2,opt,name=other_id,json=otherId,proto3" json:"other_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HitRobotEvent) Reset() {
+	*x = HitRobotEvent{}
+	mi := &file_bot_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HitRobotEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HitRobotEvent) ProtoMessage() {}
+
+func (x *HitRobotEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HitRobotEvent.ProtoReflect.Descriptor instead.
+func (*HitRobotEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HitRobotEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *HitRobotEvent) GetOtherId() string {
+	if x != nil {
+		return x.OtherId
+	}
+	return ""
+}
+
+type ZoneEnteredEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	ZoneId        string                 `protobuf:"bytes,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneEnteredEvent) Reset() {
+	*x = ZoneEnteredEvent{}
+	mi := &file_bot_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneEnteredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneEnteredEvent) ProtoMessage() {}
+
+func (x *ZoneEnteredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneEnteredEvent.ProtoReflect.Descriptor instead.
+func (*ZoneEnteredEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ZoneEnteredEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *ZoneEnteredEvent) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *ZoneEnteredEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ZoneExitedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	ZoneId        string                 `protobuf:"bytes,2,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneExitedEvent) Reset() {
+	*x = ZoneExitedEvent{}
+	mi := &file_bot_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneExitedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneExitedEvent) ProtoMessage() {}
+
+func (x *ZoneExitedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneExitedEvent.ProtoReflect.Descriptor instead.
+func (*ZoneExitedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ZoneExitedEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *ZoneExitedEvent) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *ZoneExitedEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type DeathEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	KillerId      string                 `protobuf:"bytes,2,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeathEvent) Reset() {
+	*x = DeathEvent{}
+	mi := &file_bot_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeathEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeathEvent) ProtoMessage() {}
+
+func (x *DeathEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeathEvent.ProtoReflect.Descriptor instead.
+func (*DeathEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeathEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *DeathEvent) GetKillerId() string {
+	if x != nil {
+		return x.KillerId
+	}
+	return ""
+}
+
+type MatchFinishedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WinnerId      string                 `protobuf:"bytes,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"` // Bot ID, or team ID in team mode
+	TeamStats     []*TeamStats           `protobuf:"bytes,2,rep,name=team_stats,json=teamStats,proto3" json:"team_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchFinishedEvent) Reset() {
+	*x = MatchFinishedEvent{}
+	mi := &file_bot_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchFinishedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchFinishedEvent) ProtoMessage() {}
+
+func (x *MatchFinishedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchFinishedEvent.ProtoReflect.Descriptor instead.
+func (*MatchFinishedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MatchFinishedEvent) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *MatchFinishedEvent) GetTeamStats() []*TeamStats {
+	if x != nil {
+		return x.TeamStats
+	}
+	return nil
+}
+
+type PowerActivatedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Power         PowerType              `protobuf:"varint,2,opt,name=power,proto3,enum=codearena.v1.PowerType" json:"power,omitempty"`
+	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`                         // Ticks the effect lasts; 0 for instant powers
+	AffectedIds   []string               `protobuf:"bytes,4,rep,name=affected_ids,json=affectedIds,proto3" json:"affected_ids,omitempty"` // Other bots hit by area powers such as EMP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerActivatedEvent) Reset() {
+	*x = PowerActivatedEvent{}
+	mi := &file_bot_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerActivatedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerActivatedEvent) ProtoMessage() {}
+
+func (x *PowerActivatedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerActivatedEvent.ProtoReflect.Descriptor instead.
+func (*PowerActivatedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PowerActivatedEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *PowerActivatedEvent) GetPower() PowerType {
+	if x != nil {
+		return x.Power
+	}
+	return PowerType_POWER_NONE
+}
+
+func (x *PowerActivatedEvent) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PowerActivatedEvent) GetAffectedIds() []string {
+	if x != nil {
+		return x.AffectedIds
+	}
+	return nil
+}
+
+type PowerExpiredEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Power         PowerType              `protobuf:"varint,2,opt,name=power,proto3,enum=codearena.v1.PowerType" json:"power,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerExpiredEvent) Reset() {
+	*x = PowerExpiredEvent{}
+	mi := &file_bot_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerExpiredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerExpiredEvent) ProtoMessage() {}
+
+func (x *PowerExpiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerExpiredEvent.ProtoReflect.Descriptor instead.
+func (*PowerExpiredEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PowerExpiredEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *PowerExpiredEvent) GetPower() PowerType {
+	if x != nil {
+		return x.Power
+	}
+	return PowerType_POWER_NONE
+}
+
+type BotDisconnectedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	GraceTicks    int64                  `protobuf:"varint,3,opt,name=grace_ticks,json=graceTicks,proto3" json:"grace_ticks,omitempty"` // Ticks left to reconnect before the bot forfeits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BotDisconnectedEvent) Reset() {
+	*x = BotDisconnectedEvent{}
+	mi := &file_bot_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotDisconnectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotDisconnectedEvent) ProtoMessage() {}
+
+func (x *BotDisconnectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotDisconnectedEvent.ProtoReflect.Descriptor instead.
+func (*BotDisconnectedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BotDisconnectedEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *BotDisconnectedEvent) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *BotDisconnectedEvent) GetGraceTicks() int64 {
+	if x != nil {
+		return x.GraceTicks
+	}
+	return 0
+}
+
+type BotReconnectedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	OfflineTicks  int64                  `protobuf:"varint,3,opt,name=offline_ticks,json=offlineTicks,proto3" json:"offline_ticks,omitempty"` // Ticks the bot spent disconnected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BotReconnectedEvent) Reset() {
+	*x = BotReconnectedEvent{}
+	mi := &file_bot_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotReconnectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotReconnectedEvent) ProtoMessage() {}
+
+func (x *BotReconnectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotReconnectedEvent.ProtoReflect.Descriptor instead.
+func (*BotReconnectedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BotReconnectedEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *BotReconnectedEvent) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *BotReconnectedEvent) GetOfflineTicks() int64 {
+	if x != nil {
+		return x.OfflineTicks
+	}
+	return 0
+}
+
+type PickupCollectedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	PickupId      string                 `protobuf:"bytes,2,opt,name=pickup_id,json=pickupId,proto3" json:"pickup_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Amount        float32                `protobuf:"fixed32,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupCollectedEvent) Reset() {
+	*x = PickupCollectedEvent{}
+	mi := &file_bot_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupCollectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupCollectedEvent) ProtoMessage() {}
+
+func (x *PickupCollectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupCollectedEvent.ProtoReflect.Descriptor instead.
+func (*PickupCollectedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PickupCollectedEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *PickupCollectedEvent) GetPickupId() string {
+	if x != nil {
+		return x.PickupId
+	}
+	return ""
+}
+
+func (x *PickupCollectedEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PickupCollectedEvent) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// An intent value the engine rejected or clamped; only sent to the bot concerned
+type IntentWarningEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BotId         string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"` // BotIntent field name, e.g. "turn_degrees"
+	Requested     float32                `protobuf:"fixed32,3,opt,name=requested,proto3" json:"requested,omitempty"`
+	Applied       float32                `protobuf:"fixed32,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntentWarningEvent) Reset() {
+	*x = IntentWarningEvent{}
+	mi := &file_bot_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntentWarningEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntentWarningEvent) ProtoMessage() {}
+
+func (x *IntentWarningEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntentWarningEvent.ProtoReflect.Descriptor instead.
+func (*IntentWarningEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *IntentWarningEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *IntentWarningEvent) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *IntentWarningEvent) GetRequested() float32 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *IntentWarningEvent) GetApplied() float32 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *IntentWarningEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TeamMessageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMessageEvent) Reset() {
+	*x = TeamMessageEvent{}
+	mi := &file_bot_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMessageEvent) ProtoMessage() {}
+
+func (x *TeamMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMessageEvent.ProtoReflect.Descriptor instead.
+func (*TeamMessageEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TeamMessageEvent) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *TeamMessageEvent) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TeamMessageEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TeamStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Kills         int32                  `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths        int32                  `protobuf:"varint,3,opt,name=deaths,proto3" json:"deaths,omitempty"`
+	DamageDealt   float32                `protobuf:"fixed32,4,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	Survivors     int32                  `protobuf:"varint,5,opt,name=survivors,proto3" json:"survivors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamStats) Reset() {
+	*x = TeamStats{}
+	mi := &file_bot_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStats) ProtoMessage() {}
+
+func (x *TeamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStats.ProtoReflect.Descriptor instead.
+func (*TeamStats) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TeamStats) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TeamStats) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *TeamStats) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+func (x *TeamStats) GetDamageDealt() float32 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *TeamStats) GetSurvivors() int32 {
+	if x != nil {
+		return x.Survivors
+	}
+	return 0
+}
+
+type ScannedBotEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScannerId     string                 `protobuf:"bytes,1,opt,name=scanner_id,json=scannerId,proto3" json:"scanner_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Distance      float32                `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Bearing       float32                `protobuf:"fixed32,4,opt,name=bearing,proto3" json:"bearing,omitempty"` // Absolute degrees from scanner to target, 0 = North
+	Heading       float32                `protobuf:"fixed32,5,opt,name=heading,proto3" json:"heading,omitempty"` // Target heading
+	Velocity      float32                `protobuf:"fixed32,6,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Energy        float32                `protobuf:"fixed32,7,opt,name=energy,proto3" json:"energy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScannedBotEvent) Reset() {
+	*x = ScannedBotEvent{}
+	mi := &file_bot_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScannedBotEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScannedBotEvent) ProtoMessage() {}
+
+func (x *ScannedBotEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScannedBotEvent.ProtoReflect.Descriptor instead.
+func (*ScannedBotEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ScannedBotEvent) GetScannerId() string {
+	if x != nil {
+		return x.ScannerId
+	}
+	return ""
+}
+
+func (x *ScannedBotEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ScannedBotEvent) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *ScannedBotEvent) GetBearing() float32 {
+	if x != nil {
+		return x.Bearing
+	}
+	return 0
+}
+
+func (x *ScannedBotEvent) GetHeading() float32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *ScannedBotEvent) GetVelocity() float32 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *ScannedBotEvent) GetEnergy() float32 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+type ObstacleDestroyedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObstacleId    string                 `protobuf:"bytes,1,opt,name=obstacle_id,json=obstacleId,proto3" json:"obstacle_id,omitempty"`
+	BotId         string                 `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObstacleDestroyedEvent) Reset() {
+	*x = ObstacleDestroyedEvent{}
+	mi := &file_bot_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObstacleDestroyedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObstacleDestroyedEvent) ProtoMessage() {}
+
+func (x *ObstacleDestroyedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObstacleDestroyedEvent.ProtoReflect.Descriptor instead.
+func (*ObstacleDestroyedEvent) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ObstacleDestroyedEvent) GetObstacleId() string {
+	if x != nil {
+		return x.ObstacleId
+	}
+	return ""
+}
+
+func (x *ObstacleDestroyedEvent) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type BulletState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Position      *Vector3               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Heading       float32                `protobuf:"fixed32,4,opt,name=heading,proto3" json:"heading,omitempty"`
+	Velocity      float32                `protobuf:"fixed32,5,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Power         float32                `protobuf:"fixed32,6,opt,name=power,proto3" json:"power,omitempty"`
+	TeamId        string                 `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"` // Shooter's team, for friendly fire
+	Weapon        WeaponType             `protobuf:"varint,8,opt,name=weapon,proto3,enum=codearena.v1.WeaponType" json:"weapon,omitempty"`
+	Ttl           int32                  `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"` // Ticks before the projectile expires; 0 = until it hits something
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulletState) Reset() {
+	*x = BulletState{}
+	mi := &file_bot_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulletState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulletState) ProtoMessage() {}
+
+func (x *BulletState) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulletState.ProtoReflect.Descriptor instead.
+func (*BulletState) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulletState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulletState) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *BulletState) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *BulletState) GetHeading() float32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *BulletState) GetVelocity() float32 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *BulletState) GetPower() float32 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *BulletState) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *BulletState) GetWeapon() WeaponType {
+	if x != nil {
+		return x.Weapon
+	}
+	return WeaponType_SHELL
+}
+
+func (x *BulletState) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type ObstacleState struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position       *Vector3               `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Radius         float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Indestructible bool                   `protobuf:"varint,4,opt,name=indestructible,proto3" json:"indestructible,omitempty"`
+	Hp             float32                `protobuf:"fixed32,5,opt,name=hp,proto3" json:"hp,omitempty"` // Remaining hit points (destructible only)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ObstacleState) Reset() {
+	*x = ObstacleState{}
+	mi := &file_bot_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObstacleState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObstacleState) ProtoMessage() {}
+
+func (x *ObstacleState) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObstacleState.ProtoReflect.Descriptor instead.
+func (*ObstacleState) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ObstacleState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ObstacleState) GetPosition() *Vector3 {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ObstacleState) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *ObstacleState) GetIndestructible() bool {
+	if x != nil {
+		return x.Indestructible
+	}
+	return false
+}
+
+func (x *ObstacleState) GetHp() float32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+type ZoneState struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	X              float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y              float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Radius         float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	TargetX        float32                `protobuf:"fixed32,4,opt,name=target_x,json=targetX,proto3" json:"target_x,omitempty"` // Circle the zone is heading for in the current phase
+	TargetY        float32                `protobuf:"fixed32,5,opt,name=target_y,json=targetY,proto3" json:"target_y,omitempty"`
+	TargetRadius   float32                `protobuf:"fixed32,6,opt,name=target_radius,json=targetRadius,proto3" json:"target_radius,omitempty"`
+	Phase          int32                  `protobuf:"varint,7,opt,name=phase,proto3" json:"phase,omitempty"`                                         // Index into the schedule; equals its length once the zone is final
+	Shrinking      bool                   `protobuf:"varint,8,opt,name=shrinking,proto3" json:"shrinking,omitempty"`                                 // False while holding before the shrink
+	TicksRemaining int64                  `protobuf:"varint,9,opt,name=ticks_remaining,json=ticksRemaining,proto3" json:"ticks_remaining,omitempty"` // Until the hold or the shrink ends
+	Damage         float32                `protobuf:"fixed32,10,opt,name=damage,proto3" json:"damage,omitempty"`                                     // Per tick outside the zone
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ZoneState) Reset() {
+	*x = ZoneState{}
+	mi := &file_bot_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneState) ProtoMessage() {}
+
+func (x *ZoneState) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneState.ProtoReflect.Descriptor instead.
+func (*ZoneState) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ZoneState) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ZoneState) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
 }
 
-// Deprecated: Use BulletState.ProtoReflect.Descriptor instead.
-func (*BulletState) Descriptor() ([]byte, []int) {
-	return file_bot_api_proto_rawDescGZIP(), []int{10}
+func (x *ZoneState) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
 }
 
-func (x *BulletState) GetId() string {
+func (x *ZoneState) GetTargetX() float32 {
 	if x != nil {
-		return x.Id
+		return x.TargetX
 	}
-	return ""
+	return 0
 }
 
-func (x *BulletState) GetOwnerId() string {
+func (x *ZoneState) GetTargetY() float32 {
 	if x != nil {
-		return x.OwnerId
+		return x.TargetY
 	}
-	return ""
+	return 0
 }
 
-func (x *BulletState) GetPosition() *Vector3 {
+func (x *ZoneState) GetTargetRadius() float32 {
 	if x != nil {
-		return x.Position
+		return x.TargetRadius
 	}
-	return nil
+	return 0
 }
 
-func (x *BulletState) GetHeading() float32 {
+func (x *ZoneState) GetPhase() int32 {
 	if x != nil {
-		return x.Heading
+		return x.Phase
 	}
 	return 0
 }
 
-func (x *BulletState) GetVelocity() float32 {
+func (x *ZoneState) GetShrinking() bool {
 	if x != nil {
-		return x.Velocity
+		return x.Shrinking
+	}
+	return false
+}
+
+func (x *ZoneState) GetTicksRemaining() int64 {
+	if x != nil {
+		return x.TicksRemaining
 	}
 	return 0
 }
 
-func (x *BulletState) GetPower() float32 {
+func (x *ZoneState) GetDamage() float32 {
 	if x != nil {
-		return x.Power
+		return x.Damage
 	}
 	return 0
 }
 
-type ZoneState struct {
+type PickupState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	X             float32                `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float32                `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
-	Radius        float32                `protobuf:"fixed32,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Position      *Vector3               `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Available     bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	RespawnIn     int64                  `protobuf:"varint,5,opt,name=respawn_in,json=respawnIn,proto3" json:"respawn_in,omitempty"` // Ticks until it reappears; 0 while available or if it never respawns
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ZoneState) Reset() {
-	*x = ZoneState{}
-	mi := &file_bot_api_proto_msgTypes[11]
+func (x *PickupState) Reset() {
+	*x = PickupState{}
+	mi := &file_bot_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ZoneState) String() string {
+func (x *PickupState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ZoneState) ProtoMessage() {}
+func (*PickupState) ProtoMessage() {}
 
-func (x *ZoneState) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_api_proto_msgTypes[11]
+func (x *PickupState) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,28 +3144,42 @@ func (x *ZoneState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ZoneState.ProtoReflect.Descriptor instead.
-func (*ZoneState) Descriptor() ([]byte, []int) {
-	return file_bot_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PickupState.ProtoReflect.Descriptor instead.
+func (*PickupState) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ZoneState) GetX() float32 {
+func (x *PickupState) GetId() string {
 	if x != nil {
-		return x.X
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ZoneState) GetY() float32 {
+func (x *PickupState) GetType() string {
 	if x != nil {
-		return x.Y
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *ZoneState) GetRadius() float32 {
+func (x *PickupState) GetPosition() *Vector3 {
 	if x != nil {
-		return x.Radius
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PickupState) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PickupState) GetRespawnIn() int64 {
+	if x != nil {
+		return x.RespawnIn
 	}
 	return 0
 }
@@ -1032,13 +3193,16 @@ type WorldState struct {
 	Events        []*SimulationEvent     `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
 	Bullets       []*BulletState         `protobuf:"bytes,5,rep,name=bullets,proto3" json:"bullets,omitempty"`
 	Zone          *ZoneState             `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone,omitempty"`
+	Obstacles     []*ObstacleState       `protobuf:"bytes,7,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
+	JoinAccepted  *JoinAccepted          `protobuf:"bytes,8,opt,name=join_accepted,json=joinAccepted,proto3" json:"join_accepted,omitempty"` // Only set on the first state of a Connect stream
+	Pickups       []*PickupState         `protobuf:"bytes,9,rep,name=pickups,proto3" json:"pickups,omitempty"`                               // Bots only see pickups their team can see
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorldState) Reset() {
 	*x = WorldState{}
-	mi := &file_bot_api_proto_msgTypes[12]
+	mi := &file_bot_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1050,7 +3214,7 @@ func (x *WorldState) String() string {
 func (*WorldState) ProtoMessage() {}
 
 func (x *WorldState) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_api_proto_msgTypes[12]
+	mi := &file_bot_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,7 +3227,7 @@ func (x *WorldState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldState.ProtoReflect.Descriptor instead.
 func (*WorldState) Descriptor() ([]byte, []int) {
-	return file_bot_api_proto_rawDescGZIP(), []int{12}
+	return file_bot_api_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *WorldState) GetTick() int64 {
@@ -1108,22 +3272,47 @@ func (x *WorldState) GetZone() *ZoneState {
 	return nil
 }
 
+func (x *WorldState) GetObstacles() []*ObstacleState {
+	if x != nil {
+		return x.Obstacles
+	}
+	return nil
+}
+
+func (x *WorldState) GetJoinAccepted() *JoinAccepted {
+	if x != nil {
+		return x.JoinAccepted
+	}
+	return nil
+}
+
+func (x *WorldState) GetPickups() []*PickupState {
+	if x != nil {
+		return x.Pickups
+	}
+	return nil
+}
+
 // Command intent from a bot for the next tick
 type BotIntent struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	MoveDistance     float32                `protobuf:"fixed32,1,opt,name=move_distance,json=moveDistance,proto3" json:"move_distance,omitempty"` // + forward, - backward
-	TurnDegrees      float32                `protobuf:"fixed32,2,opt,name=turn_degrees,json=turnDegrees,proto3" json:"turn_degrees,omitempty"`    // rotation
-	GunTurnDegrees   float32                `protobuf:"fixed32,3,opt,name=gun_turn_degrees,json=gunTurnDegrees,proto3" json:"gun_turn_degrees,omitempty"`
-	RadarTurnDegrees float32                `protobuf:"fixed32,4,opt,name=radar_turn_degrees,json=radarTurnDegrees,proto3" json:"radar_turn_degrees,omitempty"`
-	FirePower        float32                `protobuf:"fixed32,5,opt,name=fire_power,json=firePower,proto3" json:"fire_power,omitempty"` // 0.1 to 3.0
+	MoveDistance     float32                `protobuf:"fixed32,1,opt,name=move_distance,json=moveDistance,proto3" json:"move_distance,omitempty"`               // Distance to travel, + forward, - backward; 0 stops
+	TurnDegrees      float32                `protobuf:"fixed32,2,opt,name=turn_degrees,json=turnDegrees,proto3" json:"turn_degrees,omitempty"`                  // rotation, limited per tick by class and speed
+	GunTurnDegrees   float32                `protobuf:"fixed32,3,opt,name=gun_turn_degrees,json=gunTurnDegrees,proto3" json:"gun_turn_degrees,omitempty"`       // limited per tick by class
+	RadarTurnDegrees float32                `protobuf:"fixed32,4,opt,name=radar_turn_degrees,json=radarTurnDegrees,proto3" json:"radar_turn_degrees,omitempty"` // limited per tick by class
+	FirePower        float32                `protobuf:"fixed32,5,opt,name=fire_power,json=firePower,proto3" json:"fire_power,omitempty"`                        // 0.1 to 3.0, clamped
 	UsePower         PowerType              `protobuf:"varint,6,opt,name=use_power,json=usePower,proto3,enum=codearena.v1.PowerType" json:"use_power,omitempty"`
+	TeamMessage      string                 `protobuf:"bytes,7,opt,name=team_message,json=teamMessage,proto3" json:"team_message,omitempty"`  // Relayed to teammates, truncated to 256 bytes
+	Join             *JoinRequest           `protobuf:"bytes,8,opt,name=join,proto3" json:"join,omitempty"`                                   // Handshake, only in the first message of a Connect stream
+	Weapon           WeaponType             `protobuf:"varint,9,opt,name=weapon,proto3,enum=codearena.v1.WeaponType" json:"weapon,omitempty"` // Fired with fire_power; must be one of the class's weapons
+	Tick             int64                  `protobuf:"varint,10,opt,name=tick,proto3" json:"tick,omitempty"`                                 // Tick of the state this intent answers; required under an intent_deadline, where 0 is stale
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *BotIntent) Reset() {
 	*x = BotIntent{}
-	mi := &file_bot_api_proto_msgTypes[13]
+	mi := &file_bot_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1135,7 +3324,7 @@ func (x *BotIntent) String() string {
 func (*BotIntent) ProtoMessage() {}
 
 func (x *BotIntent) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_api_proto_msgTypes[13]
+	mi := &file_bot_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,7 +3337,7 @@ func (x *BotIntent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BotIntent.ProtoReflect.Descriptor instead.
 func (*BotIntent) Descriptor() ([]byte, []int) {
-	return file_bot_api_proto_rawDescGZIP(), []int{13}
+	return file_bot_api_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *BotIntent) GetMoveDistance() float32 {
@@ -1193,6 +3382,228 @@ func (x *BotIntent) GetUsePower() PowerType {
 	return PowerType_POWER_NONE
 }
 
+func (x *BotIntent) GetTeamMessage() string {
+	if x != nil {
+		return x.TeamMessage
+	}
+	return ""
+}
+
+func (x *BotIntent) GetJoin() *JoinRequest {
+	if x != nil {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *BotIntent) GetWeapon() WeaponType {
+	if x != nil {
+		return x.Weapon
+	}
+	return WeaponType_SHELL
+}
+
+func (x *BotIntent) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+// Identifies a bot when it joins a match
+type JoinRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MatchId         string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`       // Falls back to "match-id" metadata, then the default match
+	BotId           string                 `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`             // Generated if empty
+	AuthToken       string                 `protobuf:"bytes,3,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"` // Required when the engine has JWT_SECRET set
+	Class           string                 `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`                          // Tank (default), Scout, Sniper
+	TeamId          string                 `protobuf:"bytes,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	BotVersion      string                 `protobuf:"bytes,6,opt,name=bot_version,json=botVersion,proto3" json:"bot_version,omitempty"`
+	ProtocolVersion int32                  `protobuf:"varint,7,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Name            string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	SessionToken    string                 `protobuf:"bytes,9,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // From JoinAccepted; resumes a dropped session as the same bot
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_bot_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *JoinRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetBotVersion() string {
+	if x != nil {
+		return x.BotVersion
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// First server message of a Connect stream, attached to the initial WorldState
+type JoinAccepted struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BotId           string                 `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	MatchId         string                 `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Arena           *ArenaConfig           `protobuf:"bytes,3,opt,name=arena,proto3" json:"arena,omitempty"`
+	ProtocolVersion int32                  `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	SessionToken    string                 `protobuf:"bytes,5,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // Present it in a JoinRequest to reconnect within the grace period
+	Resumed         bool                   `protobuf:"varint,6,opt,name=resumed,proto3" json:"resumed,omitempty"`                              // The stream took over an existing session
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JoinAccepted) Reset() {
+	*x = JoinAccepted{}
+	mi := &file_bot_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinAccepted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinAccepted) ProtoMessage() {}
+
+func (x *JoinAccepted) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinAccepted.ProtoReflect.Descriptor instead.
+func (*JoinAccepted) Descriptor() ([]byte, []int) {
+	return file_bot_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *JoinAccepted) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *JoinAccepted) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *JoinAccepted) GetArena() *ArenaConfig {
+	if x != nil {
+		return x.Arena
+	}
+	return nil
+}
+
+func (x *JoinAccepted) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *JoinAccepted) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *JoinAccepted) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
 var File_bot_api_proto protoreflect.FileDescriptor
 
 const file_bot_api_proto_rawDesc = "" +
@@ -1201,7 +3612,75 @@ const file_bot_api_proto_rawDesc = "" +
 	"\aVector3\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x02R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x02R\x01y\x12\f\n" +
-	"\x01z\x18\x03 \x01(\x02R\x01z\"\x8f\x04\n" +
+	"\x01z\x18\x03 \x01(\x02R\x01z\"\xfb\x06\n" +
+	"\vArenaConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x02R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x02R\x06height\x124\n" +
+	"\tobstacles\x18\x05 \x03(\v2\x16.codearena.v1.ObstacleR\tobstacles\x12(\n" +
+	"\x05zones\x18\x06 \x03(\v2\x12.codearena.v1.ZoneR\x05zones\x12\x19\n" +
+	"\bmax_bots\x18\a \x01(\x05R\amaxBots\x120\n" +
+	"\x14match_duration_ticks\x18\b \x01(\x03R\x12matchDurationTicks\x12#\n" +
+	"\rwin_condition\x18\t \x01(\tR\fwinCondition\x12!\n" +
+	"\fscore_target\x18\n" +
+	" \x01(\x05R\vscoreTarget\x12\x1a\n" +
+	"\btiebreak\x18\v \x01(\tR\btiebreak\x12\x1b\n" +
+	"\tteam_mode\x18\f \x01(\bR\bteamMode\x12#\n" +
+	"\rfriendly_fire\x18\r \x01(\bR\ffriendlyFire\x12\x12\n" +
+	"\x04seed\x18\x0e \x01(\x03R\x04seed\x12\x1b\n" +
+	"\tclass_set\x18\x0f \x01(\tR\bclassSet\x12?\n" +
+	"\rzone_schedule\x18\x10 \x01(\v2\x1a.codearena.v1.ZoneScheduleR\fzoneSchedule\x12+\n" +
+	"\x11bullet_collisions\x18\x11 \x01(\bR\x10bulletCollisions\x123\n" +
+	"\apickups\x18\x12 \x03(\v2\x19.codearena.v1.PickupSpawnR\apickups\x12;\n" +
+	"\fspawn_points\x18\x13 \x03(\v2\x18.codearena.v1.SpawnPointR\vspawnPoints\x12;\n" +
+	"\fbuiltin_bots\x18\x14 \x03(\v2\x18.codearena.v1.BuiltinBotR\vbuiltinBots\x122\n" +
+	"\x15reconnect_grace_ticks\x18\x15 \x01(\x03R\x13reconnectGraceTicks\x12E\n" +
+	"\x0fintent_deadline\x18\x16 \x01(\v2\x1c.codearena.v1.IntentDeadlineR\x0eintentDeadline\"k\n" +
+	"\x0eIntentDeadline\x12\x14\n" +
+	"\x05ticks\x18\x01 \x01(\x03R\x05ticks\x12\x17\n" +
+	"\aon_miss\x18\x02 \x01(\tR\x06onMiss\x12*\n" +
+	"\x11max_skipped_turns\x18\x03 \x01(\x05R\x0fmaxSkippedTurns\"_\n" +
+	"\n" +
+	"BuiltinBot\x12\x0e\n" +
+	"\x02ai\x18\x01 \x01(\tR\x02ai\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05class\x18\x03 \x01(\tR\x05class\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\tR\x06teamId\"r\n" +
+	"\n" +
+	"SpawnPoint\x121\n" +
+	"\bposition\x18\x01 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x18\n" +
+	"\aheading\x18\x02 \x01(\x02R\aheading\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\tR\x06teamId\"\xa1\x01\n" +
+	"\vPickupSpawn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\bposition\x18\x02 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x02R\x06amount\x12#\n" +
+	"\rrespawn_ticks\x18\x05 \x01(\x03R\frespawnTicks\"\x91\x01\n" +
+	"\fZoneSchedule\x12-\n" +
+	"\x06center\x18\x01 \x01(\v2\x15.codearena.v1.Vector3R\x06center\x12!\n" +
+	"\fstart_radius\x18\x02 \x01(\x02R\vstartRadius\x12/\n" +
+	"\x06phases\x18\x03 \x03(\v2\x17.codearena.v1.ZonePhaseR\x06phases\"\xa7\x01\n" +
+	"\tZonePhase\x12\x1d\n" +
+	"\n" +
+	"wait_ticks\x18\x01 \x01(\x03R\twaitTicks\x12!\n" +
+	"\fshrink_ticks\x18\x02 \x01(\x03R\vshrinkTicks\x12#\n" +
+	"\rtarget_radius\x18\x03 \x01(\x02R\ftargetRadius\x12\x1b\n" +
+	"\tmax_drift\x18\x04 \x01(\x02R\bmaxDrift\x12\x16\n" +
+	"\x06damage\x18\x05 \x01(\x02R\x06damage\"\x9d\x01\n" +
+	"\bObstacle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\bposition\x18\x02 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12&\n" +
+	"\x0eindestructible\x18\x04 \x01(\bR\x0eindestructible\x12\x0e\n" +
+	"\x02hp\x18\x05 \x01(\x02R\x02hp\"\x93\x01\n" +
+	"\x04Zone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\bposition\x18\x02 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
+	"\tintensity\x18\x05 \x01(\x02R\tintensity\"\xe6\x05\n" +
 	"\bBotState\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
@@ -1216,13 +3695,24 @@ const file_bot_api_proto_rawDesc = "" +
 	"\x04heat\x18\n" +
 	" \x01(\x02R\x04heat\x12\x1b\n" +
 	"\tshield_hp\x18\v \x01(\x02R\bshieldHp\x12!\n" +
-	"\fis_stealthed\x18\f \x01(\bR\visStealthed\x12C\n" +
-	"\tcooldowns\x18\r \x03(\v2%.codearena.v1.BotState.CooldownsEntryR\tcooldowns\x12\x14\n" +
+	"\fis_stealthed\x18\f \x01(\bR\visStealthed\x12G\n" +
+	"\tcooldowns\x18\r \x03(\v2%.codearena.v1.BotState.CooldownsEntryB\x02\x18\x01R\tcooldowns\x12\x14\n" +
 	"\x05class\x18\x0e \x01(\tR\x05class\x12\x17\n" +
-	"\ateam_id\x18\x0f \x01(\tR\x06teamId\x1a<\n" +
+	"\ateam_id\x18\x0f \x01(\tR\x06teamId\x12\x19\n" +
+	"\bzone_ids\x18\x10 \x03(\tR\azoneIds\x12!\n" +
+	"\fradar_jammed\x18\x11 \x01(\bR\vradarJammed\x122\n" +
+	"\aweapons\x18\x12 \x03(\x0e2\x18.codearena.v1.WeaponTypeR\aweapons\x12-\n" +
+	"\x12distance_remaining\x18\x13 \x01(\x02R\x11distanceRemaining\x120\n" +
+	"\x06powers\x18\x14 \x03(\v2\x18.codearena.v1.PowerStateR\x06powers\x1a<\n" +
 	"\x0eCooldownsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xfd\x03\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"x\n" +
+	"\n" +
+	"PowerState\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.codearena.v1.PowerTypeR\x04type\x12\x1a\n" +
+	"\bcooldown\x18\x02 \x01(\x05R\bcooldown\x12!\n" +
+	"\factive_ticks\x18\x03 \x01(\x05R\vactiveTicks\"\xc0\n" +
+	"\n" +
 	"\x0fSimulationEvent\x12\x12\n" +
 	"\x04tick\x18\x01 \x01(\x03R\x04tick\x12D\n" +
 	"\rhit_by_bullet\x18\x02 \x01(\v2\x1e.codearena.v1.HitByBulletEventH\x00R\vhitByBullet\x12P\n" +
@@ -1231,41 +3721,143 @@ const file_bot_api_proto_rawDesc = "" +
 	"\thit_robot\x18\x05 \x01(\v2\x1b.codearena.v1.HitRobotEventH\x00R\bhitRobot\x12C\n" +
 	"\fzone_entered\x18\x06 \x01(\v2\x1e.codearena.v1.ZoneEnteredEventH\x00R\vzoneEntered\x120\n" +
 	"\x05death\x18\a \x01(\v2\x18.codearena.v1.DeathEventH\x00R\x05death\x12I\n" +
-	"\x0ematch_finished\x18\b \x01(\v2 .codearena.v1.MatchFinishedEventH\x00R\rmatchFinishedB\a\n" +
+	"\x0ematch_finished\x18\b \x01(\v2 .codearena.v1.MatchFinishedEventH\x00R\rmatchFinished\x12U\n" +
+	"\x12obstacle_destroyed\x18\t \x01(\v2$.codearena.v1.ObstacleDestroyedEventH\x00R\x11obstacleDestroyed\x12@\n" +
+	"\vscanned_bot\x18\n" +
+	" \x01(\v2\x1d.codearena.v1.ScannedBotEventH\x00R\n" +
+	"scannedBot\x12C\n" +
+	"\fteam_message\x18\v \x01(\v2\x1e.codearena.v1.TeamMessageEventH\x00R\vteamMessage\x12@\n" +
+	"\vzone_exited\x18\f \x01(\v2\x1d.codearena.v1.ZoneExitedEventH\x00R\n" +
+	"zoneExited\x12P\n" +
+	"\x11bullet_hit_bullet\x18\r \x01(\v2\".codearena.v1.BulletHitBulletEventH\x00R\x0fbulletHitBullet\x12I\n" +
+	"\x0eintent_warning\x18\x0e \x01(\v2 .codearena.v1.IntentWarningEventH\x00R\rintentWarning\x12L\n" +
+	"\x0fpower_activated\x18\x0f \x01(\v2!.codearena.v1.PowerActivatedEventH\x00R\x0epowerActivated\x12F\n" +
+	"\rpower_expired\x18\x10 \x01(\v2\x1f.codearena.v1.PowerExpiredEventH\x00R\fpowerExpired\x12O\n" +
+	"\x10pickup_collected\x18\x11 \x01(\v2\".codearena.v1.PickupCollectedEventH\x00R\x0fpickupCollected\x12O\n" +
+	"\x10bot_disconnected\x18\x12 \x01(\v2\".codearena.v1.BotDisconnectedEventH\x00R\x0fbotDisconnected\x12L\n" +
+	"\x0fbot_reconnected\x18\x13 \x01(\v2!.codearena.v1.BotReconnectedEventH\x00R\x0ebotReconnectedB\a\n" +
 	"\x05event\"d\n" +
 	"\x10HitByBulletEvent\x12\x1b\n" +
 	"\tvictim_id\x18\x01 \x01(\tR\bvictimId\x12\x1b\n" +
 	"\tbullet_id\x18\x02 \x01(\tR\bbulletId\x12\x16\n" +
-	"\x06damage\x18\x03 \x01(\x02R\x06damage\"P\n" +
+	"\x06damage\x18\x03 \x01(\x02R\x06damage\"\x83\x01\n" +
 	"\x14BulletHitTargetEvent\x12\x1b\n" +
 	"\tbullet_id\x18\x01 \x01(\tR\bbulletId\x12\x1b\n" +
-	"\ttarget_id\x18\x02 \x01(\tR\btargetId\"%\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06damage\x18\x04 \x01(\x02R\x06damage\"%\n" +
 	"\fHitWallEvent\x12\x15\n" +
-	"\x06bot_id\x18\x01 \x01(\tR\x05botId\"A\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\"\x9c\x01\n" +
+	"\x14BulletHitBulletEvent\x12\x1b\n" +
+	"\tbullet_id\x18\x01 \x01(\tR\bbulletId\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12&\n" +
+	"\x0fother_bullet_id\x18\x03 \x01(\tR\rotherBulletId\x12$\n" +
+	"\x0eother_owner_id\x18\x04 \x01(\tR\fotherOwnerId\"A\n" +
 	"\rHitRobotEvent\x12\x15\n" +
 	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x19\n" +
 	"\bother_id\x18\x02 \x01(\tR\aotherId\"V\n" +
 	"\x10ZoneEnteredEvent\x12\x15\n" +
 	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x17\n" +
 	"\azone_id\x18\x02 \x01(\tR\x06zoneId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"U\n" +
+	"\x0fZoneExitedEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x17\n" +
+	"\azone_id\x18\x02 \x01(\tR\x06zoneId\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\"@\n" +
 	"\n" +
 	"DeathEvent\x12\x15\n" +
 	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x1b\n" +
-	"\tkiller_id\x18\x02 \x01(\tR\bkillerId\"1\n" +
+	"\tkiller_id\x18\x02 \x01(\tR\bkillerId\"i\n" +
 	"\x12MatchFinishedEvent\x12\x1b\n" +
-	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\"\xb7\x01\n" +
+	"\twinner_id\x18\x01 \x01(\tR\bwinnerId\x126\n" +
+	"\n" +
+	"team_stats\x18\x02 \x03(\v2\x17.codearena.v1.TeamStatsR\tteamStats\"\x9a\x01\n" +
+	"\x13PowerActivatedEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12-\n" +
+	"\x05power\x18\x02 \x01(\x0e2\x17.codearena.v1.PowerTypeR\x05power\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x05R\bduration\x12!\n" +
+	"\faffected_ids\x18\x04 \x03(\tR\vaffectedIds\"Y\n" +
+	"\x11PowerExpiredEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12-\n" +
+	"\x05power\x18\x02 \x01(\x0e2\x17.codearena.v1.PowerTypeR\x05power\"g\n" +
+	"\x14BotDisconnectedEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12\x1f\n" +
+	"\vgrace_ticks\x18\x03 \x01(\x03R\n" +
+	"graceTicks\"j\n" +
+	"\x13BotReconnectedEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12#\n" +
+	"\roffline_ticks\x18\x03 \x01(\x03R\fofflineTicks\"v\n" +
+	"\x14PickupCollectedEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x1b\n" +
+	"\tpickup_id\x18\x02 \x01(\tR\bpickupId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x02R\x06amount\"\x91\x01\n" +
+	"\x12IntentWarningEvent\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x1c\n" +
+	"\trequested\x18\x03 \x01(\x02R\trequested\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\x02R\aapplied\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"b\n" +
+	"\x10TeamMessageEvent\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x93\x01\n" +
+	"\tTeamStats\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x14\n" +
+	"\x05kills\x18\x02 \x01(\x05R\x05kills\x12\x16\n" +
+	"\x06deaths\x18\x03 \x01(\x05R\x06deaths\x12!\n" +
+	"\fdamage_dealt\x18\x04 \x01(\x02R\vdamageDealt\x12\x1c\n" +
+	"\tsurvivors\x18\x05 \x01(\x05R\tsurvivors\"\xd1\x01\n" +
+	"\x0fScannedBotEvent\x12\x1d\n" +
+	"\n" +
+	"scanner_id\x18\x01 \x01(\tR\tscannerId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x02R\bdistance\x12\x18\n" +
+	"\abearing\x18\x04 \x01(\x02R\abearing\x12\x18\n" +
+	"\aheading\x18\x05 \x01(\x02R\aheading\x12\x1a\n" +
+	"\bvelocity\x18\x06 \x01(\x02R\bvelocity\x12\x16\n" +
+	"\x06energy\x18\a \x01(\x02R\x06energy\"P\n" +
+	"\x16ObstacleDestroyedEvent\x12\x1f\n" +
+	"\vobstacle_id\x18\x01 \x01(\tR\n" +
+	"obstacleId\x12\x15\n" +
+	"\x06bot_id\x18\x02 \x01(\tR\x05botId\"\x94\x02\n" +
 	"\vBulletState\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\tR\aownerId\x121\n" +
 	"\bposition\x18\x03 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x18\n" +
 	"\aheading\x18\x04 \x01(\x02R\aheading\x12\x1a\n" +
 	"\bvelocity\x18\x05 \x01(\x02R\bvelocity\x12\x14\n" +
-	"\x05power\x18\x06 \x01(\x02R\x05power\"?\n" +
+	"\x05power\x18\x06 \x01(\x02R\x05power\x12\x17\n" +
+	"\ateam_id\x18\a \x01(\tR\x06teamId\x120\n" +
+	"\x06weapon\x18\b \x01(\x0e2\x18.codearena.v1.WeaponTypeR\x06weapon\x12\x10\n" +
+	"\x03ttl\x18\t \x01(\x05R\x03ttl\"\xa2\x01\n" +
+	"\rObstacleState\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\bposition\x18\x02 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12&\n" +
+	"\x0eindestructible\x18\x04 \x01(\bR\x0eindestructible\x12\x0e\n" +
+	"\x02hp\x18\x05 \x01(\x02R\x02hp\"\x8f\x02\n" +
 	"\tZoneState\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x02R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x02R\x01y\x12\x16\n" +
-	"\x06radius\x18\x03 \x01(\x02R\x06radius\"\x98\x02\n" +
+	"\x06radius\x18\x03 \x01(\x02R\x06radius\x12\x19\n" +
+	"\btarget_x\x18\x04 \x01(\x02R\atargetX\x12\x19\n" +
+	"\btarget_y\x18\x05 \x01(\x02R\atargetY\x12#\n" +
+	"\rtarget_radius\x18\x06 \x01(\x02R\ftargetRadius\x12\x14\n" +
+	"\x05phase\x18\a \x01(\x05R\x05phase\x12\x1c\n" +
+	"\tshrinking\x18\b \x01(\bR\tshrinking\x12'\n" +
+	"\x0fticks_remaining\x18\t \x01(\x03R\x0eticksRemaining\x12\x16\n" +
+	"\x06damage\x18\n" +
+	" \x01(\x02R\x06damage\"\xa1\x01\n" +
+	"\vPickupState\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x121\n" +
+	"\bposition\x18\x03 \x01(\v2\x15.codearena.v1.Vector3R\bposition\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\x12\x1d\n" +
+	"\n" +
+	"respawn_in\x18\x05 \x01(\x03R\trespawnIn\"\xc9\x03\n" +
 	"\n" +
 	"WorldState\x12\x12\n" +
 	"\x04tick\x18\x01 \x01(\x03R\x04tick\x121\n" +
@@ -1273,7 +3865,10 @@ const file_bot_api_proto_rawDesc = "" +
 	"\x04bots\x18\x03 \x03(\v2\x16.codearena.v1.BotStateR\x04bots\x125\n" +
 	"\x06events\x18\x04 \x03(\v2\x1d.codearena.v1.SimulationEventR\x06events\x123\n" +
 	"\abullets\x18\x05 \x03(\v2\x19.codearena.v1.BulletStateR\abullets\x12+\n" +
-	"\x04zone\x18\x06 \x01(\v2\x17.codearena.v1.ZoneStateR\x04zone\"\x80\x02\n" +
+	"\x04zone\x18\x06 \x01(\v2\x17.codearena.v1.ZoneStateR\x04zone\x129\n" +
+	"\tobstacles\x18\a \x03(\v2\x1b.codearena.v1.ObstacleStateR\tobstacles\x12?\n" +
+	"\rjoin_accepted\x18\b \x01(\v2\x1a.codearena.v1.JoinAcceptedR\fjoinAccepted\x123\n" +
+	"\apickups\x18\t \x03(\v2\x19.codearena.v1.PickupStateR\apickups\"\x98\x03\n" +
 	"\tBotIntent\x12#\n" +
 	"\rmove_distance\x18\x01 \x01(\x02R\fmoveDistance\x12!\n" +
 	"\fturn_degrees\x18\x02 \x01(\x02R\vturnDegrees\x12(\n" +
@@ -1281,19 +3876,55 @@ const file_bot_api_proto_rawDesc = "" +
 	"\x12radar_turn_degrees\x18\x04 \x01(\x02R\x10radarTurnDegrees\x12\x1d\n" +
 	"\n" +
 	"fire_power\x18\x05 \x01(\x02R\tfirePower\x124\n" +
-	"\tuse_power\x18\x06 \x01(\x0e2\x17.codearena.v1.PowerTypeR\busePower*S\n" +
+	"\tuse_power\x18\x06 \x01(\x0e2\x17.codearena.v1.PowerTypeR\busePower\x12!\n" +
+	"\fteam_message\x18\a \x01(\tR\vteamMessage\x12-\n" +
+	"\x04join\x18\b \x01(\v2\x19.codearena.v1.JoinRequestR\x04join\x120\n" +
+	"\x06weapon\x18\t \x01(\x0e2\x18.codearena.v1.WeaponTypeR\x06weapon\x12\x12\n" +
+	"\x04tick\x18\n" +
+	" \x01(\x03R\x04tick\"\x92\x02\n" +
+	"\vJoinRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x15\n" +
+	"\x06bot_id\x18\x02 \x01(\tR\x05botId\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x03 \x01(\tR\tauthToken\x12\x14\n" +
+	"\x05class\x18\x04 \x01(\tR\x05class\x12\x17\n" +
+	"\ateam_id\x18\x05 \x01(\tR\x06teamId\x12\x1f\n" +
+	"\vbot_version\x18\x06 \x01(\tR\n" +
+	"botVersion\x12)\n" +
+	"\x10protocol_version\x18\a \x01(\x05R\x0fprotocolVersion\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\x12#\n" +
+	"\rsession_token\x18\t \x01(\tR\fsessionToken\"\xdb\x01\n" +
+	"\fJoinAccepted\x12\x15\n" +
+	"\x06bot_id\x18\x01 \x01(\tR\x05botId\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12/\n" +
+	"\x05arena\x18\x03 \x01(\v2\x19.codearena.v1.ArenaConfigR\x05arena\x12)\n" +
+	"\x10protocol_version\x18\x04 \x01(\x05R\x0fprotocolVersion\x12#\n" +
+	"\rsession_token\x18\x05 \x01(\tR\fsessionToken\x12\x18\n" +
+	"\aresumed\x18\x06 \x01(\bR\aresumed*_\n" +
 	"\vMatchStatus\x12\x1c\n" +
 	"\x18MATCH_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aWAITING\x10\x01\x12\v\n" +
 	"\aRUNNING\x10\x02\x12\f\n" +
-	"\bFINISHED\x10\x03*C\n" +
+	"\bFINISHED\x10\x03\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x04*b\n" +
 	"\tPowerType\x12\x0e\n" +
 	"\n" +
 	"POWER_NONE\x10\x00\x12\n" +
 	"\n" +
 	"\x06SHIELD\x10\x01\x12\r\n" +
 	"\tOVERCLOCK\x10\x02\x12\v\n" +
-	"\aSTEALTH\x10\x032N\n" +
+	"\aSTEALTH\x10\x03\x12\n" +
+	"\n" +
+	"\x06REPAIR\x10\x04\x12\a\n" +
+	"\x03EMP\x10\x05\x12\b\n" +
+	"\x04DASH\x10\x06*9\n" +
+	"\n" +
+	"WeaponType\x12\t\n" +
+	"\x05SHELL\x10\x00\x12\t\n" +
+	"\x05LASER\x10\x01\x12\v\n" +
+	"\aMISSILE\x10\x02\x12\b\n" +
+	"\x04MINE\x10\x032N\n" +
 	"\n" +
 	"BotService\x12@\n" +
 	"\aConnect\x12\x17.codearena.v1.BotIntent\x1a\x18.codearena.v1.WorldState(\x010\x01B9Z7github.com/codearena-platform/codearena-core/pkg/api/v1b\x06proto3"
@@ -1310,51 +3941,117 @@ func file_bot_api_proto_rawDescGZIP() []byte {
 	return file_bot_api_proto_rawDescData
 }
 
-var file_bot_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_bot_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_bot_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_bot_api_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_bot_api_proto_goTypes = []any{
-	(MatchStatus)(0),             // 0: codearena.v1.MatchStatus
-	(PowerType)(0),               // 1: codearena.v1.PowerType
-	(*Vector3)(nil),              // 2: codearena.v1.Vector3
-	(*BotState)(nil),             // 3: codearena.v1.BotState
-	(*SimulationEvent)(nil),      // 4: codearena.v1.SimulationEvent
-	(*HitByBulletEvent)(nil),     // 5: codearena.v1.HitByBulletEvent
-	(*BulletHitTargetEvent)(nil), // 6: codearena.v1.BulletHitTargetEvent
-	(*HitWallEvent)(nil),         // 7: codearena.v1.HitWallEvent
-	(*HitRobotEvent)(nil),        // 8: codearena.v1.HitRobotEvent
-	(*ZoneEnteredEvent)(nil),     // 9: codearena.v1.ZoneEnteredEvent
-	(*DeathEvent)(nil),           // 10: codearena.v1.DeathEvent
-	(*MatchFinishedEvent)(nil),   // 11: codearena.v1.MatchFinishedEvent
-	(*BulletState)(nil),          // 12: codearena.v1.BulletState
-	(*ZoneState)(nil),            // 13: codearena.v1.ZoneState
-	(*WorldState)(nil),           // 14: codearena.v1.WorldState
-	(*BotIntent)(nil),            // 15: codearena.v1.BotIntent
-	nil,                          // 16: codearena.v1.BotState.CooldownsEntry
+	(MatchStatus)(0),               // 0: codearena.v1.MatchStatus
+	(PowerType)(0),                 // 1: codearena.v1.PowerType
+	(WeaponType)(0),                // 2: codearena.v1.WeaponType
+	(*Vector3)(nil),                // 3: codearena.v1.Vector3
+	(*ArenaConfig)(nil),            // 4: codearena.v1.ArenaConfig
+	(*IntentDeadline)(nil),         // 5: codearena.v1.IntentDeadline
+	(*BuiltinBot)(nil),             // 6: codearena.v1.BuiltinBot
+	(*SpawnPoint)(nil),             // 7: codearena.v1.SpawnPoint
+	(*PickupSpawn)(nil),            // 8: codearena.v1.PickupSpawn
+	(*ZoneSchedule)(nil),           // 9: codearena.v1.ZoneSchedule
+	(*ZonePhase)(nil),              // 10: codearena.v1.ZonePhase
+	(*Obstacle)(nil),               // 11: codearena.v1.Obstacle
+	(*Zone)(nil),                   // 12: codearena.v1.Zone
+	(*BotState)(nil),               // 13: codearena.v1.BotState
+	(*PowerState)(nil),             // 14: codearena.v1.PowerState
+	(*SimulationEvent)(nil),        // 15: codearena.v1.SimulationEvent
+	(*HitByBulletEvent)(nil),       // 16: codearena.v1.HitByBulletEvent
+	(*BulletHitTargetEvent)(nil),   // 17: codearena.v1.BulletHitTargetEvent
+	(*HitWallEvent)(nil),           // 18: codearena.v1.HitWallEvent
+	(*BulletHitBulletEvent)(nil),   // 19: codearena.v1.BulletHitBulletEvent
+	(*HitRobotEvent)(nil),          // 20: codearena.v1.HitRobotEvent
+	(*ZoneEnteredEvent)(nil),       // 21: codearena.v1.ZoneEnteredEvent
+	(*ZoneExitedEvent)(nil),        // 22: codearena.v1.ZoneExitedEvent
+	(*DeathEvent)(nil),             // 23: codearena.v1.DeathEvent
+	(*MatchFinishedEvent)(nil),     // 24: codearena.v1.MatchFinishedEvent
+	(*PowerActivatedEvent)(nil),    // 25: codearena.v1.PowerActivatedEvent
+	(*PowerExpiredEvent)(nil),      // 26: codearena.v1.PowerExpiredEvent
+	(*BotDisconnectedEvent)(nil),   // 27: codearena.v1.BotDisconnectedEvent
+	(*BotReconnectedEvent)(nil),    // 28: codearena.v1.BotReconnectedEvent
+	(*PickupCollectedEvent)(nil),   // 29: codearena.v1.PickupCollectedEvent
+	(*IntentWarningEvent)(nil),     // 30: codearena.v1.IntentWarningEvent
+	(*TeamMessageEvent)(nil),       // 31: codearena.v1.TeamMessageEvent
+	(*TeamStats)(nil),              // 32: codearena.v1.TeamStats
+	(*ScannedBotEvent)(nil),        // 33: codearena.v1.ScannedBotEvent
+	(*ObstacleDestroyedEvent)(nil), // 34: codearena.v1.ObstacleDestroyedEvent
+	(*BulletState)(nil),            // 35: codearena.v1.BulletState
+	(*ObstacleState)(nil),          // 36: codearena.v1.ObstacleState
+	(*ZoneState)(nil),              // 37: codearena.v1.ZoneState
+	(*PickupState)(nil),            // 38: codearena.v1.PickupState
+	(*WorldState)(nil),             // 39: codearena.v1.WorldState
+	(*BotIntent)(nil),              // 40: codearena.v1.BotIntent
+	(*JoinRequest)(nil),            // 41: codearena.v1.JoinRequest
+	(*JoinAccepted)(nil),           // 42: codearena.v1.JoinAccepted
+	nil,                            // 43: codearena.v1.BotState.CooldownsEntry
 }
 var file_bot_api_proto_depIdxs = []int32{
-	2,  // 0: codearena.v1.BotState.position:type_name -> codearena.v1.Vector3
-	16, // 1: codearena.v1.BotState.cooldowns:type_name -> codearena.v1.BotState.CooldownsEntry
-	5,  // 2: codearena.v1.SimulationEvent.hit_by_bullet:type_name -> codearena.v1.HitByBulletEvent
-	6,  // 3: codearena.v1.SimulationEvent.bullet_hit_target:type_name -> codearena.v1.BulletHitTargetEvent
-	7,  // 4: codearena.v1.SimulationEvent.hit_wall:type_name -> codearena.v1.HitWallEvent
-	8,  // 5: codearena.v1.SimulationEvent.hit_robot:type_name -> codearena.v1.HitRobotEvent
-	9,  // 6: codearena.v1.SimulationEvent.zone_entered:type_name -> codearena.v1.ZoneEnteredEvent
-	10, // 7: codearena.v1.SimulationEvent.death:type_name -> codearena.v1.DeathEvent
-	11, // 8: codearena.v1.SimulationEvent.match_finished:type_name -> codearena.v1.MatchFinishedEvent
-	2,  // 9: codearena.v1.BulletState.position:type_name -> codearena.v1.Vector3
-	0,  // 10: codearena.v1.WorldState.status:type_name -> codearena.v1.MatchStatus
-	3,  // 11: codearena.v1.WorldState.bots:type_name -> codearena.v1.BotState
-	4,  // 12: codearena.v1.WorldState.events:type_name -> codearena.v1.SimulationEvent
-	12, // 13: codearena.v1.WorldState.bullets:type_name -> codearena.v1.BulletState
-	13, // 14: codearena.v1.WorldState.zone:type_name -> codearena.v1.ZoneState
-	1,  // 15: codearena.v1.BotIntent.use_power:type_name -> codearena.v1.PowerType
-	15, // 16: codearena.v1.BotService.Connect:input_type -> codearena.v1.BotIntent
-	14, // 17: codearena.v1.BotService.Connect:output_type -> codearena.v1.WorldState
-	17, // [17:18] is the sub-list for method output_type
-	16, // [16:17] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	11, // 0: codearena.v1.ArenaConfig.obstacles:type_name -> codearena.v1.Obstacle
+	12, // 1: codearena.v1.ArenaConfig.zones:type_name -> codearena.v1.Zone
+	9,  // 2: codearena.v1.ArenaConfig.zone_schedule:type_name -> codearena.v1.ZoneSchedule
+	8,  // 3: codearena.v1.ArenaConfig.pickups:type_name -> codearena.v1.PickupSpawn
+	7,  // 4: codearena.v1.ArenaConfig.spawn_points:type_name -> codearena.v1.SpawnPoint
+	6,  // 5: codearena.v1.ArenaConfig.builtin_bots:type_name -> codearena.v1.BuiltinBot
+	5,  // 6: codearena.v1.ArenaConfig.intent_deadline:type_name -> codearena.v1.IntentDeadline
+	3,  // 7: codearena.v1.SpawnPoint.position:type_name -> codearena.v1.Vector3
+	3,  // 8: codearena.v1.PickupSpawn.position:type_name -> codearena.v1.Vector3
+	3,  // 9: codearena.v1.ZoneSchedule.center:type_name -> codearena.v1.Vector3
+	10, // 10: codearena.v1.ZoneSchedule.phases:type_name -> codearena.v1.ZonePhase
+	3,  // 11: codearena.v1.Obstacle.position:type_name -> codearena.v1.Vector3
+	3,  // 12: codearena.v1.Zone.position:type_name -> codearena.v1.Vector3
+	3,  // 13: codearena.v1.BotState.position:type_name -> codearena.v1.Vector3
+	43, // 14: codearena.v1.BotState.cooldowns:type_name -> codearena.v1.BotState.CooldownsEntry
+	2,  // 15: codearena.v1.BotState.weapons:type_name -> codearena.v1.WeaponType
+	14, // 16: codearena.v1.BotState.powers:type_name -> codearena.v1.PowerState
+	1,  // 17: codearena.v1.PowerState.type:type_name -> codearena.v1.PowerType
+	16, // 18: codearena.v1.SimulationEvent.hit_by_bullet:type_name -> codearena.v1.HitByBulletEvent
+	17, // 19: codearena.v1.SimulationEvent.bullet_hit_target:type_name -> codearena.v1.BulletHitTargetEvent
+	18, // 20: codearena.v1.SimulationEvent.hit_wall:type_name -> codearena.v1.HitWallEvent
+	20, // 21: codearena.v1.SimulationEvent.hit_robot:type_name -> codearena.v1.HitRobotEvent
+	21, // 22: codearena.v1.SimulationEvent.zone_entered:type_name -> codearena.v1.ZoneEnteredEvent
+	23, // 23: codearena.v1.SimulationEvent.death:type_name -> codearena.v1.DeathEvent
+	24, // 24: codearena.v1.SimulationEvent.match_finished:type_name -> codearena.v1.MatchFinishedEvent
+	34, // 25: codearena.v1.SimulationEvent.obstacle_destroyed:type_name -> codearena.v1.ObstacleDestroyedEvent
+	33, // 26: codearena.v1.SimulationEvent.scanned_bot:type_name -> codearena.v1.ScannedBotEvent
+	31, // 27: codearena.v1.SimulationEvent.team_message:type_name -> codearena.v1.TeamMessageEvent
+	22, // 28: codearena.v1.SimulationEvent.zone_exited:type_name -> codearena.v1.ZoneExitedEvent
+	19, // 29: codearena.v1.SimulationEvent.bullet_hit_bullet:type_name -> codearena.v1.BulletHitBulletEvent
+	30, // 30: codearena.v1.SimulationEvent.intent_warning:type_name -> codearena.v1.IntentWarningEvent
+	25, // 31: codearena.v1.SimulationEvent.power_activated:type_name -> codearena.v1.PowerActivatedEvent
+	26, // 32: codearena.v1.SimulationEvent.power_expired:type_name -> codearena.v1.PowerExpiredEvent
+	29, // 33: codearena.v1.SimulationEvent.pickup_collected:type_name -> codearena.v1.PickupCollectedEvent
+	27, // 34: codearena.v1.SimulationEvent.bot_disconnected:type_name -> codearena.v1.BotDisconnectedEvent
+	28, // 35: codearena.v1.SimulationEvent.bot_reconnected:type_name -> codearena.v1.BotReconnectedEvent
+	32, // 36: codearena.v1.MatchFinishedEvent.team_stats:type_name -> codearena.v1.TeamStats
+	1,  // 37: codearena.v1.PowerActivatedEvent.power:type_name -> codearena.v1.PowerType
+	1,  // 38: codearena.v1.PowerExpiredEvent.power:type_name -> codearena.v1.PowerType
+	3,  // 39: codearena.v1.BulletState.position:type_name -> codearena.v1.Vector3
+	2,  // 40: codearena.v1.BulletState.weapon:type_name -> codearena.v1.WeaponType
+	3,  // 41: codearena.v1.ObstacleState.position:type_name -> codearena.v1.Vector3
+	3,  // 42: codearena.v1.PickupState.position:type_name -> codearena.v1.Vector3
+	0,  // 43: codearena.v1.WorldState.status:type_name -> codearena.v1.MatchStatus
+	13, // 44: codearena.v1.WorldState.bots:type_name -> codearena.v1.BotState
+	15, // 45: codearena.v1.WorldState.events:type_name -> codearena.v1.SimulationEvent
+	35, // 46: codearena.v1.WorldState.bullets:type_name -> codearena.v1.BulletState
+	37, // 47: codearena.v1.WorldState.zone:type_name -> codearena.v1.ZoneState
+	36, // 48: codearena.v1.WorldState.obstacles:type_name -> codearena.v1.ObstacleState
+	42, // 49: codearena.v1.WorldState.join_accepted:type_name -> codearena.v1.JoinAccepted
+	38, // 50: codearena.v1.WorldState.pickups:type_name -> codearena.v1.PickupState
+	1,  // 51: codearena.v1.BotIntent.use_power:type_name -> codearena.v1.PowerType
+	41, // 52: codearena.v1.BotIntent.join:type_name -> codearena.v1.JoinRequest
+	2,  // 53: codearena.v1.BotIntent.weapon:type_name -> codearena.v1.WeaponType
+	4,  // 54: codearena.v1.JoinAccepted.arena:type_name -> codearena.v1.ArenaConfig
+	40, // 55: codearena.v1.BotService.Connect:input_type -> codearena.v1.BotIntent
+	39, // 56: codearena.v1.BotService.Connect:output_type -> codearena.v1.WorldState
+	56, // [56:57] is the sub-list for method output_type
+	55, // [55:56] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_bot_api_proto_init() }
@@ -1362,7 +4059,7 @@ func file_bot_api_proto_init() {
 	if File_bot_api_proto != nil {
 		return
 	}
-	file_bot_api_proto_msgTypes[2].OneofWrappers = []any{
+	file_bot_api_proto_msgTypes[12].OneofWrappers = []any{
 		(*SimulationEvent_HitByBullet)(nil),
 		(*SimulationEvent_BulletHitTarget)(nil),
 		(*SimulationEvent_HitWall)(nil),
@@ -1370,14 +4067,25 @@ func file_bot_api_proto_init() {
 		(*SimulationEvent_ZoneEntered)(nil),
 		(*SimulationEvent_Death)(nil),
 		(*SimulationEvent_MatchFinished)(nil),
+		(*SimulationEvent_ObstacleDestroyed)(nil),
+		(*SimulationEvent_ScannedBot)(nil),
+		(*SimulationEvent_TeamMessage)(nil),
+		(*SimulationEvent_ZoneExited)(nil),
+		(*SimulationEvent_BulletHitBullet)(nil),
+		(*SimulationEvent_IntentWarning)(nil),
+		(*SimulationEvent_PowerActivated)(nil),
+		(*SimulationEvent_PowerExpired)(nil),
+		(*SimulationEvent_PickupCollected)(nil),
+		(*SimulationEvent_BotDisconnected)(nil),
+		(*SimulationEvent_BotReconnected)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bot_api_proto_rawDesc), len(file_bot_api_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
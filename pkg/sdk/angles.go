@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"math"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// Angles follow the engine: degrees, 0 = North (towards negative Y),
+// increasing clockwise, with headings kept in [0, 360).
+
+// NormalizeAngle maps an angle onto [0, 360), like the engine does for
+// headings.
+func NormalizeAngle(angle float32) float32 {
+	a := math.Mod(float64(angle), 360)
+	if a < 0 {
+		a += 360
+	}
+	return float32(a)
+}
+
+// RelativeAngle maps an angle onto [-180, 180), the shortest turn that covers
+// it.
+func RelativeAngle(angle float32) float32 {
+	return float32(math.Mod(float64(NormalizeAngle(angle))+180, 360) - 180)
+}
+
+// Bearing returns the heading that points from one position to another.
+func Bearing(from, to *pb.Vector3) float32 {
+	rad := math.Atan2(float64(to.X-from.X), float64(from.Y-to.Y))
+	return NormalizeAngle(float32(rad * 180 / math.Pi))
+}
+
+// TurnTo returns the shortest turn from heading to target.
+func TurnTo(heading, target float32) float32 {
+	return RelativeAngle(target - heading)
+}
+
+// Distance returns the distance between two positions.
+func Distance(from, to *pb.Vector3) float32 {
+	return float32(math.Hypot(float64(to.X-from.X), float64(to.Y-from.Y)))
+}
+
+// Project returns the position reached by travelling distance along heading.
+func Project(from *pb.Vector3, heading, distance float32) *pb.Vector3 {
+	rad := float64(heading) * math.Pi / 180
+	return &pb.Vector3{
+		X: from.X + float32(math.Sin(rad))*distance,
+		Y: from.Y - float32(math.Cos(rad))*distance,
+	}
+}
@@ -0,0 +1,53 @@
+package sdk
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-3
+}
+
+func TestAngles(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      float32
+		expected float32
+	}{
+		{"Normalize negative", NormalizeAngle(-90), 270},
+		{"Normalize wraps", NormalizeAngle(725), 5},
+		{"Relative over half turn", RelativeAngle(270), -90},
+		{"Relative half turn", RelativeAngle(180), -180},
+		{"TurnTo across north", TurnTo(350, 10), 20},
+		{"TurnTo backwards", TurnTo(10, 350), -20},
+		{"Bearing north", Bearing(&pb.Vector3{X: 100, Y: 100}, &pb.Vector3{X: 100, Y: 0}), 0},
+		{"Bearing east", Bearing(&pb.Vector3{X: 100, Y: 100}, &pb.Vector3{X: 200, Y: 100}), 90},
+		{"Bearing south", Bearing(&pb.Vector3{X: 100, Y: 100}, &pb.Vector3{X: 100, Y: 200}), 180},
+		{"Bearing west", Bearing(&pb.Vector3{X: 100, Y: 100}, &pb.Vector3{X: 0, Y: 100}), 270},
+		{"Distance", Distance(&pb.Vector3{}, &pb.Vector3{X: 3, Y: 4}), 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !near(tt.got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.got)
+			}
+		})
+	}
+}
+
+func TestProject_MatchesBearing(t *testing.T) {
+	from := &pb.Vector3{X: 400, Y: 300}
+	for _, heading := range []float32{0, 45, 90, 200, 315} {
+		to := Project(from, heading, 100)
+		if !near(Bearing(from, to), heading) {
+			t.Errorf("Expected bearing %v, got %v", heading, Bearing(from, to))
+		}
+		if !near(Distance(from, to), 100) {
+			t.Errorf("Expected distance 100, got %v", Distance(from, to))
+		}
+	}
+}
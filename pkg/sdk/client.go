@@ -0,0 +1,153 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Options configures how Run connects a bot.
+type Options struct {
+	Addr string            // Engine gRPC address; defaults to localhost:50051
+	Join *pb.JoinRequest   // Match, class, team and credentials of the bot
+	Dial []grpc.DialOption // Defaults to an insecure connection
+
+	// MaxReconnects is how many times in a row a dropped stream is
+	// reconnected before Run gives up; 0 selects 5, negative never reconnects.
+	MaxReconnects int
+	// ReconnectDelay is the wait before the first reconnect, doubled for each
+	// further attempt; defaults to 500ms.
+	ReconnectDelay time.Duration
+}
+
+const (
+	defaultAddr           = "localhost:50051"
+	defaultMaxReconnects  = 5
+	defaultReconnectDelay = 500 * time.Millisecond
+	maxReconnectDelay     = 10 * time.Second
+)
+
+// Run connects bot to a match and plays until the match finishes, the engine
+// ends the stream or ctx is cancelled. A stream that drops is reconnected and
+// resumes the same bot with the session token the engine issued on join.
+func Run(ctx context.Context, bot Bot, opts Options) error {
+	if opts.Addr == "" {
+		opts.Addr = defaultAddr
+	}
+	if opts.Dial == nil {
+		opts.Dial = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	if opts.MaxReconnects == 0 {
+		opts.MaxReconnects = defaultMaxReconnects
+	}
+	if opts.ReconnectDelay <= 0 {
+		opts.ReconnectDelay = defaultReconnectDelay
+	}
+	join := &pb.JoinRequest{}
+	if opts.Join != nil {
+		join = proto.Clone(opts.Join).(*pb.JoinRequest)
+	}
+
+	conn, err := grpc.NewClient(opts.Addr, opts.Dial...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := pb.NewBotServiceClient(conn)
+
+	driver := NewDriver(bot)
+	failures := 0
+	for {
+		played, err := play(ctx, client, join, driver)
+		if err == nil || ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if played {
+			failures = 0
+		}
+		if !reconnectable(err) || failures >= opts.MaxReconnects {
+			return err
+		}
+
+		delay := opts.ReconnectDelay << failures
+		if delay > maxReconnectDelay || delay <= 0 {
+			delay = maxReconnectDelay
+		}
+		failures++
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// play runs one Connect stream and reports whether the bot got to play on it.
+func play(ctx context.Context, client pb.BotServiceClient, join *pb.JoinRequest, driver *Driver) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.Connect(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := stream.Send(&pb.BotIntent{Join: join}); err != nil {
+		return false, err
+	}
+
+	played := false
+	for {
+		st, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return played, nil
+		}
+		if err != nil {
+			return played, err
+		}
+		if accepted := st.JoinAccepted; accepted != nil && accepted.SessionToken != "" {
+			// Reconnects resume the same bot in the same match; the engine
+			// only lets a bot ID back in with its session token
+			join.BotId = accepted.BotId
+			join.MatchId = accepted.MatchId
+			join.SessionToken = accepted.SessionToken
+		}
+		played = true
+
+		intent := driver.Intent(st)
+		if st.Status == pb.MatchStatus_FINISHED {
+			return played, nil
+		}
+		if intent == nil {
+			continue
+		}
+		if intent.Tick == 0 {
+			// Bots may hand back the same intent every tick, so stamp a copy
+			intent = proto.Clone(intent).(*pb.BotIntent)
+			intent.Tick = st.Tick
+		}
+		// io.EOF means the engine ended the stream; the next Recv says why
+		if err := stream.Send(intent); err != nil && !errors.Is(err, io.EOF) {
+			return played, err
+		}
+	}
+}
+
+// reconnectable reports whether a stream error may go away by reconnecting.
+func reconnectable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.AlreadyExists:
+		// The engine has not noticed the old stream dropping yet
+		return true
+	}
+	return false
+}
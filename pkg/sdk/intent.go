@@ -0,0 +1,86 @@
+package sdk
+
+import (
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// IntentBuilder assembles a BotIntent:
+//
+//	sdk.NewIntent().Move(100).TurnGunTo(self.GunHeading, bearing).Fire(2).Build()
+type IntentBuilder struct {
+	intent *pb.BotIntent
+}
+
+func NewIntent() *IntentBuilder {
+	return &IntentBuilder{intent: &pb.BotIntent{}}
+}
+
+// Move travels the given distance, forward when positive and backward when
+// negative. The move continues over later ticks until it is done.
+func (b *IntentBuilder) Move(distance float32) *IntentBuilder {
+	b.intent.MoveDistance = distance
+	return b
+}
+
+// Turn rotates the body by degrees, clockwise when positive.
+func (b *IntentBuilder) Turn(degrees float32) *IntentBuilder {
+	b.intent.TurnDegrees = degrees
+	return b
+}
+
+// TurnTo rotates the body from its heading towards target the shortest way.
+func (b *IntentBuilder) TurnTo(heading, target float32) *IntentBuilder {
+	return b.Turn(TurnTo(heading, target))
+}
+
+// TurnGun rotates the gun by degrees, clockwise when positive.
+func (b *IntentBuilder) TurnGun(degrees float32) *IntentBuilder {
+	b.intent.GunTurnDegrees = degrees
+	return b
+}
+
+// TurnGunTo rotates the gun from its heading towards target the shortest way.
+func (b *IntentBuilder) TurnGunTo(heading, target float32) *IntentBuilder {
+	return b.TurnGun(TurnTo(heading, target))
+}
+
+// TurnRadar rotates the radar by degrees, clockwise when positive.
+func (b *IntentBuilder) TurnRadar(degrees float32) *IntentBuilder {
+	b.intent.RadarTurnDegrees = degrees
+	return b
+}
+
+// TurnRadarTo rotates the radar from its heading towards target the shortest
+// way.
+func (b *IntentBuilder) TurnRadarTo(heading, target float32) *IntentBuilder {
+	return b.TurnRadar(TurnTo(heading, target))
+}
+
+// Fire shoots a shell with the given power, from 0.1 to 3.
+func (b *IntentBuilder) Fire(power float32) *IntentBuilder {
+	return b.FireWeapon(pb.WeaponType_SHELL, power)
+}
+
+// FireWeapon shoots one of the class's weapons with the given power.
+func (b *IntentBuilder) FireWeapon(weapon pb.WeaponType, power float32) *IntentBuilder {
+	b.intent.Weapon = weapon
+	b.intent.FirePower = power
+	return b
+}
+
+// UsePower activates a power if it is ready and affordable.
+func (b *IntentBuilder) UsePower(power pb.PowerType) *IntentBuilder {
+	b.intent.UsePower = power
+	return b
+}
+
+// Say relays a message to the bot's teammates.
+func (b *IntentBuilder) Say(message string) *IntentBuilder {
+	b.intent.TeamMessage = message
+	return b
+}
+
+// Build returns the intent. The builder must not be used afterwards.
+func (b *IntentBuilder) Build() *pb.BotIntent {
+	return b.intent
+}
@@ -0,0 +1,75 @@
+// Package samples holds example bots built on the sdk package. They are kept
+// small on purpose; read them as a starting point for your own bot.
+package samples
+
+import (
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/codearena-platform/codearena-core/pkg/sdk"
+)
+
+// Spinner drives in circles with its radar spinning and fires at whatever
+// the radar sweeps over.
+type Spinner struct {
+	sdk.BaseBot
+	Scans  int
+	target *pb.ScannedBotEvent
+}
+
+func (b *Spinner) OnScanned(scan *pb.ScannedBotEvent) {
+	b.Scans++
+	b.target = scan
+}
+
+func (b *Spinner) OnTick(s *sdk.State) *pb.BotIntent {
+	self := s.Self()
+	intent := sdk.NewIntent().Move(50).Turn(5).TurnRadar(30)
+	if b.target != nil {
+		intent.TurnGunTo(self.GunHeading, b.target.Bearing).Fire(1)
+		b.target = nil
+	}
+	return intent.Build()
+}
+
+// Tracker locks its radar onto the first bot it scans, closes in and fires
+// harder the closer it gets.
+type Tracker struct {
+	sdk.BaseBot
+	Hits   int
+	Died   bool
+	target *pb.ScannedBotEvent
+}
+
+func (b *Tracker) OnScanned(scan *pb.ScannedBotEvent) {
+	if b.target == nil || b.target.TargetId == scan.TargetId {
+		b.target = scan
+	}
+}
+
+func (b *Tracker) OnHitByBullet(*pb.HitByBulletEvent) { b.Hits++ }
+func (b *Tracker) OnDeath(*pb.DeathEvent)             { b.Died = true }
+
+func (b *Tracker) OnTick(s *sdk.State) *pb.BotIntent {
+	self := s.Self()
+	if b.target == nil {
+		return sdk.NewIntent().TurnRadar(45).Build()
+	}
+
+	// Sweep past the target so the radar keeps crossing it
+	overshoot := float32(10)
+	if sdk.TurnTo(self.RadarHeading, b.target.Bearing) < 0 {
+		overshoot = -overshoot
+	}
+	power := float32(3)
+	if b.target.Distance > 300 {
+		power = 1
+	}
+	intent := sdk.NewIntent().
+		TurnRadar(sdk.TurnTo(self.RadarHeading, b.target.Bearing)+overshoot).
+		TurnGunTo(self.GunHeading, b.target.Bearing).
+		TurnTo(self.Heading, b.target.Bearing).
+		Fire(power)
+	if b.target.Distance > 150 {
+		intent.Move(b.target.Distance - 150)
+	}
+	return intent.Build()
+}
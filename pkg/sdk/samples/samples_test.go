@@ -0,0 +1,186 @@
+package samples
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codearena-platform/codearena-core/internal/engine/routes"
+	"github.com/codearena-platform/codearena-core/internal/engine/services"
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+	"github.com/codearena-platform/codearena-core/pkg/sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakyServer cuts the first drops Connect streams after cutAfter states, like
+// a network failure would.
+type flakyServer struct {
+	*routes.SimulationServer
+	mu       sync.Mutex
+	drops    int
+	cutAfter int
+}
+
+func (s *flakyServer) Connect(stream pb.BotService_ConnectServer) error {
+	s.mu.Lock()
+	drop := s.drops > 0
+	if drop {
+		s.drops--
+	}
+	s.mu.Unlock()
+	if drop {
+		return s.SimulationServer.Connect(&cutStream{BotService_ConnectServer: stream, left: s.cutAfter})
+	}
+	return s.SimulationServer.Connect(stream)
+}
+
+// cutStream fails once it has sent left states.
+type cutStream struct {
+	pb.BotService_ConnectServer
+	left int
+}
+
+func (s *cutStream) Send(st *pb.WorldState) error {
+	if s.left == 0 {
+		return status.Error(codes.Unavailable, "connection lost")
+	}
+	s.left--
+	return s.BotService_ConnectServer.Send(st)
+}
+
+// startArena serves a match over an in-memory listener and returns the
+// options bots need to reach it.
+func startArena(t *testing.T, e *services.SimulationEngine, drops, cutAfter int) (*routes.SimulationServer, []grpc.DialOption) {
+	s := routes.NewSimulationServer(e, time.Millisecond)
+	lis := bufconn.Listen(1 << 20)
+	grpcSrv := grpc.NewServer()
+	pb.RegisterBotServiceServer(grpcSrv, &flakyServer{SimulationServer: s, drops: drops, cutAfter: cutAfter})
+	go grpcSrv.Serve(lis)
+	t.Cleanup(grpcSrv.Stop)
+
+	return s, []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+}
+
+// playMatch runs the bots until the match ends and returns their Run errors.
+// The first drops streams are cut after cutAfter states.
+func playMatch(t *testing.T, e *services.SimulationEngine, drops, cutAfter int, bots ...sdk.Bot) []error {
+	s, dial := startArena(t, e, drops, cutAfter)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	errs := make([]error, len(bots))
+	var wg sync.WaitGroup
+	for i, bot := range bots {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = sdk.Run(ctx, bot, sdk.Options{
+				Addr:           "passthrough:///bufnet",
+				Dial:           dial,
+				ReconnectDelay: time.Millisecond,
+			})
+		}()
+	}
+
+	for len(e.GetBotSlice()) < len(bots) {
+		if ctx.Err() != nil {
+			t.Fatal("Timed out waiting for the bots to join")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if _, err := s.StartSimulation(ctx, &pb.ArenaConfig{}); err != nil {
+		t.Fatalf("Failed to start the match: %v", err)
+	}
+	wg.Wait()
+	return errs
+}
+
+func newArena() *services.SimulationEngine {
+	e := services.NewSimulationEngine(800, 600, nil)
+	e.SetArenaConfig(&pb.ArenaConfig{Width: 800, Height: 600, Seed: 3, MatchDurationTicks: 1500})
+	return e
+}
+
+func TestSamples_PlayMatch(t *testing.T) {
+	spinner, tracker := &Spinner{}, &Tracker{}
+	e := newArena()
+	for i, err := range playMatch(t, e, 0, 0, spinner, tracker) {
+		if err != nil {
+			t.Errorf("Bot %d ended with an error: %v", i, err)
+		}
+	}
+
+	if e.Status != pb.MatchStatus_FINISHED {
+		t.Errorf("Expected the match to finish, got %s", e.Status)
+	}
+	if spinner.Scans == 0 {
+		t.Error("Expected the spinner to scan the tracker")
+	}
+	stats := e.Stats["bot_2"]
+	if stats == nil || (stats.DamageDealt == 0 && tracker.Hits == 0) {
+		t.Errorf("Expected the tracker to fight, got %+v and %d hits", stats, tracker.Hits)
+	}
+}
+
+func TestRun_Reconnects(t *testing.T) {
+	spinner, tracker := &Spinner{}, &Tracker{}
+	e := newArena()
+	// Both bots lose their first stream and must resume their session
+	for i, err := range playMatch(t, e, 2, 5, spinner, tracker) {
+		if err != nil {
+			t.Errorf("Bot %d ended with an error: %v", i, err)
+		}
+	}
+	if len(e.Stats) != 2 {
+		t.Errorf("Expected the same two bots to have played, got %d", len(e.Stats))
+	}
+	if spinner.Scans == 0 {
+		t.Error("Expected the spinner to keep playing after reconnecting")
+	}
+}
+
+func TestRun_ReconnectsAfterHandshake(t *testing.T) {
+	spinner, tracker := &Spinner{}, &Tracker{}
+	e := newArena()
+	// Both streams drop right after the join handshake, before the match starts
+	for i, err := range playMatch(t, e, 2, 1, spinner, tracker) {
+		if err != nil {
+			t.Errorf("Bot %d ended with an error: %v", i, err)
+		}
+	}
+	if len(e.Stats) != 2 {
+		t.Errorf("Expected the same two bots to have played, got %d", len(e.Stats))
+	}
+	if e.Status != pb.MatchStatus_FINISHED {
+		t.Errorf("Expected the match to finish, got %s", e.Status)
+	}
+}
+
+// repeater sends the same intent every tick.
+type repeater struct {
+	sdk.BaseBot
+	intent *pb.BotIntent
+}
+
+func (b *repeater) OnTick(*sdk.State) *pb.BotIntent { return b.intent }
+
+func TestRun_KeepsBotIntents(t *testing.T) {
+	bot := &repeater{intent: sdk.NewIntent().TurnRadar(45).Build()}
+	for i, err := range playMatch(t, newArena(), 0, 0, bot, &Tracker{}) {
+		if err != nil {
+			t.Errorf("Bot %d ended with an error: %v", i, err)
+		}
+	}
+	if bot.intent.Tick != 0 {
+		t.Errorf("Expected the bot's own intent to be left alone, got tick %d", bot.intent.Tick)
+	}
+}
@@ -0,0 +1,114 @@
+// Package sdk helps write CodeArena bots in Go. A bot implements Bot, usually
+// by embedding BaseBot and overriding the callbacks it needs, and is played
+// with Run:
+//
+//	type Sitter struct{ sdk.BaseBot }
+//
+//	func (Sitter) OnTick(s *sdk.State) *pb.BotIntent {
+//		return sdk.NewIntent().TurnRadar(45).Build()
+//	}
+//
+//	err := sdk.Run(ctx, Sitter{}, sdk.Options{Join: &pb.JoinRequest{Name: "sitter"}})
+package sdk
+
+import (
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+// Bot reacts to what it sees each tick. The event callbacks for a tick run
+// before its OnTick, so OnTick can act on them.
+type Bot interface {
+	// OnTick returns the bot's intent for the next tick, or nil to idle. It is
+	// not called once the bot is dead. Run sends nothing for nil, which counts
+	// as a skipped turn in arenas with an intent deadline.
+	OnTick(state *State) *pb.BotIntent
+	// OnScanned is called when the bot's radar sweeps over another bot.
+	OnScanned(scan *pb.ScannedBotEvent)
+	// OnHitByBullet is called when a projectile hits the bot.
+	OnHitByBullet(hit *pb.HitByBulletEvent)
+	// OnDeath is called once when the bot is destroyed.
+	OnDeath(death *pb.DeathEvent)
+}
+
+// BaseBot implements Bot doing nothing. Bots embed it and override the
+// callbacks they need.
+type BaseBot struct{}
+
+func (BaseBot) OnTick(*State) *pb.BotIntent        { return nil }
+func (BaseBot) OnScanned(*pb.ScannedBotEvent)      {}
+func (BaseBot) OnHitByBullet(*pb.HitByBulletEvent) {}
+func (BaseBot) OnDeath(*pb.DeathEvent)             {}
+
+// State is a bot's view of one tick.
+type State struct {
+	*pb.WorldState
+	BotID string
+	Arena *pb.ArenaConfig // From the join handshake; nil if none was seen
+}
+
+// Self returns the bot's own state, or nil once it is dead.
+func (s *State) Self() *pb.BotState {
+	// The engine lists the viewer first
+	if len(s.Bots) > 0 && s.Bots[0].Id == s.BotID {
+		return s.Bots[0]
+	}
+	return nil
+}
+
+// Enemies returns the visible bots that are not on the bot's team.
+func (s *State) Enemies() []*pb.BotState {
+	self := s.Self()
+	var enemies []*pb.BotState
+	for _, b := range s.Bots {
+		if b.Id == s.BotID || (self != nil && self.TeamId != "" && b.TeamId == self.TeamId) {
+			continue
+		}
+		enemies = append(enemies, b)
+	}
+	return enemies
+}
+
+// Driver feeds the states a bot receives to its callbacks. Run uses one per
+// bot; its Intent method also lets a bot run inside the engine process.
+type Driver struct {
+	bot   Bot
+	botID string
+	arena *pb.ArenaConfig
+	dead  bool
+}
+
+func NewDriver(bot Bot) *Driver {
+	return &Driver{bot: bot}
+}
+
+// Intent dispatches the events of one state and returns the bot's intent.
+func (d *Driver) Intent(view *pb.WorldState) *pb.BotIntent {
+	if accepted := view.JoinAccepted; accepted != nil {
+		d.botID = accepted.BotId
+		d.arena = accepted.Arena
+	}
+	if d.botID == "" && len(view.Bots) > 0 {
+		d.botID = view.Bots[0].Id
+	}
+
+	for _, ev := range view.Events {
+		if scan := ev.GetScannedBot(); scan != nil && scan.ScannerId == d.botID {
+			d.bot.OnScanned(scan)
+		} else if hit := ev.GetHitByBullet(); hit != nil && hit.VictimId == d.botID {
+			d.bot.OnHitByBullet(hit)
+		} else if death := ev.GetDeath(); death != nil && death.BotId == d.botID && !d.dead {
+			d.dead = true
+			d.bot.OnDeath(death)
+		}
+	}
+
+	if d.dead {
+		return nil
+	}
+	return d.bot.OnTick(&State{WorldState: view, BotID: d.botID, Arena: d.arena})
+}
+
+// BotID returns the ID the engine assigned to the bot, once known.
+func (d *Driver) BotID() string {
+	return d.botID
+}
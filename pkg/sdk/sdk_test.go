@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"testing"
+
+	pb "github.com/codearena-platform/codearena-core/pkg/api/v1"
+)
+
+type recorder struct {
+	BaseBot
+	ticks, scans, hits, deaths int
+}
+
+func (r *recorder) OnTick(*State) *pb.BotIntent {
+	r.ticks++
+	return NewIntent().Move(10).Fire(2).Build()
+}
+func (r *recorder) OnScanned(*pb.ScannedBotEvent)      { r.scans++ }
+func (r *recorder) OnHitByBullet(*pb.HitByBulletEvent) { r.hits++ }
+func (r *recorder) OnDeath(*pb.DeathEvent)             { r.deaths++ }
+
+func TestDriver_DispatchesOwnEvents(t *testing.T) {
+	bot := &recorder{}
+	d := NewDriver(bot)
+
+	view := &pb.WorldState{
+		Bots: []*pb.BotState{{Id: "me"}, {Id: "other"}},
+		Events: []*pb.SimulationEvent{
+			{Event: &pb.SimulationEvent_ScannedBot{ScannedBot: &pb.ScannedBotEvent{ScannerId: "me", TargetId: "other"}}},
+			{Event: &pb.SimulationEvent_ScannedBot{ScannedBot: &pb.ScannedBotEvent{ScannerId: "other", TargetId: "me"}}},
+			{Event: &pb.SimulationEvent_HitByBullet{HitByBullet: &pb.HitByBulletEvent{VictimId: "me"}}},
+			{Event: &pb.SimulationEvent_HitByBullet{HitByBullet: &pb.HitByBulletEvent{VictimId: "other"}}},
+		},
+	}
+	intent := d.Intent(view)
+	if d.BotID() != "me" {
+		t.Errorf("Expected bot ID me, got %s", d.BotID())
+	}
+	if bot.scans != 1 || bot.hits != 1 || bot.ticks != 1 {
+		t.Errorf("Expected 1 scan, 1 hit and 1 tick, got %d, %d and %d", bot.scans, bot.hits, bot.ticks)
+	}
+	if intent.MoveDistance != 10 || intent.FirePower != 2 || intent.Weapon != pb.WeaponType_SHELL {
+		t.Errorf("Expected the bot's intent, got %v", intent)
+	}
+
+	death := &pb.SimulationEvent{Event: &pb.SimulationEvent_Death{Death: &pb.DeathEvent{BotId: "me"}}}
+	for i := 0; i < 2; i++ {
+		if intent := d.Intent(&pb.WorldState{Events: []*pb.SimulationEvent{death}}); intent != nil {
+			t.Errorf("Expected no intent once dead, got %v", intent)
+		}
+	}
+	if bot.deaths != 1 || bot.ticks != 1 {
+		t.Errorf("Expected one death and no further ticks, got %d and %d", bot.deaths, bot.ticks)
+	}
+}
+
+func TestState_Enemies(t *testing.T) {
+	s := &State{
+		BotID: "a",
+		WorldState: &pb.WorldState{Bots: []*pb.BotState{
+			{Id: "a", TeamId: "red"}, {Id: "b", TeamId: "red"}, {Id: "c", TeamId: "blue"},
+		}},
+	}
+	if s.Self().Id != "a" {
+		t.Errorf("Expected self a, got %s", s.Self().Id)
+	}
+	enemies := s.Enemies()
+	if len(enemies) != 1 || enemies[0].Id != "c" {
+		t.Errorf("Expected only c as an enemy, got %v", enemies)
+	}
+}